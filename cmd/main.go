@@ -12,20 +12,30 @@ import (
 	"strings"
 	"syscall"
 	"time"
+	"xrf197ilz35aq2/core/service"
+	sessionV1 "xrf197ilz35aq2/gen/go/service/session/v1"
+	bidV1 "xrf197ilz35aq2/gen/go/service/v1"
 	"xrf197ilz35aq2/internal"
+	"xrf197ilz35aq2/internal/worker"
+	"xrf197ilz35aq2/server/gateway"
 	"xrf197ilz35aq2/server/grpc"
+	"xrf197ilz35aq2/server/health"
+	"xrf197ilz35aq2/server/identity"
 	"xrf197ilz35aq2/server/socket"
 	"xrf197ilz35aq2/storage"
 	"xrf197ilz35aq2/storage/postgres"
 	"xrf197ilz35aq2/storage/redis"
 	"xrf197ilz35aq2/storage/timescale"
-	"xrf197ilz35aq2/validators"
+	"xrf197ilz35aq2/storage/timescale/queries"
 
-	"github.com/go-playground/validator/v10"
 	"golang.org/x/sync/errgroup"
+	grpcHealth "google.golang.org/grpc/health"
 )
 
-const gRPCPortAddress = ":50052"
+const (
+	gRPCPortAddress    = ":50052"
+	gRPCGatewayAddress = "localhost" + gRPCPortAddress // the JSON gateway forwards to the gRPC server over loopback
+)
 
 func main() {
 	env := getAppEnv()
@@ -43,21 +53,12 @@ func main() {
 	}
 
 	// setup Databases
-	_, err = setTimescaleDB(config, *logger)
+	tsPool, err := setTimescaleDB(config, *logger)
 	if err != nil {
 		logger.Error("Failed to setup timescaleDB: %s\n", "err", err)
 		return
 	}
 
-	var validate *validator.Validate
-	validate = validator.New()
-
-	err = validate.RegisterValidation("auctionType", validators.AuctionTypeValidator)
-	if err != nil {
-		logger.Error("Register auctionType validation error: %s\n", "err", err)
-		return
-	}
-
 	// /////// Set up redis client
 	redisClient, err := storage.NewRedisClient(context.Background(), config.Redis)
 	if err != nil {
@@ -74,17 +75,67 @@ func main() {
 	defer pgPool.Close()
 
 	cacheClient := redis.CacheClients{
-		BidClient: redis.NewBidCache(*logger, redisClient),
+		BidClient:         redis.NewBidCache(*logger, redisClient),
+		IdempotencyClient: redis.NewIdempotencyCache(*logger, redisClient),
 	}
 	allRepos := postgres.Repositories{
-		BidRepository:     postgres.NewBidRepo(pgPool.Pool, *logger),
-		SessionRepository: postgres.NewSessionRepository(pgPool.Pool, *logger),
+		BidRepository:        postgres.NewBidRepo(pgPool.Pool, *logger),
+		SessionRepository:    postgres.NewSessionRepository(pgPool.Pool, *logger),
+		CommitmentRepository: postgres.NewCommitmentRepository(pgPool.Pool, *logger),
+		SettlementRepository: postgres.NewSettlementRepository(pgPool.Pool, *logger),
+		ProxyBidRepository:   postgres.NewProxyBidRepository(pgPool.Pool, *logger),
+	}
+
+	bidWorker := worker.NewBidWorker(*logger, redisClient, cacheClient.BidClient, worker.NewJobsConfig(5*time.Second, 100*time.Millisecond),
+		allRepos.BidRepository, queries.NewBidTSQuerier(tsPool.Pool, *logger))
+
+	probes := map[string]health.Probe{
+		health.Postgres:    pgPool.Ping,
+		health.TimescaleDB: tsPool.Ping,
+		health.Redis: func(ctx context.Context) error {
+			return redisClient.Ping(ctx).Err()
+		},
+	}
+
+	sessions, err := service.NewSessionService(*logger, allRepos.SessionRepository)
+	if err != nil {
+		logger.Error("failed to create session service", "err", err)
+		return
 	}
 
-	runApp(logger, cacheClient, allRepos)
+	verifier, err := identity.NewTokenVerifier(config.Auth.TokenSecret)
+	if err != nil {
+		logger.Error("failed to create credential verifier", "err", err)
+		return
+	}
+	// a websocket token must never pass for a credential
+	if config.WebSocket.TokenSecret == config.Auth.TokenSecret {
+		logger.Error("the websocket token secret must differ from the credential token secret")
+		return
+	}
+	tokenSigner, err := identity.NewTokenSigner(config.WebSocket.TokenSecret, time.Duration(config.WebSocket.TokenTTL)*time.Second)
+	if err != nil {
+		logger.Error("failed to create websocket token signer", "err", err)
+		return
+	}
+	wsAuth := socket.NewAuthenticator(verifier, tokenSigner, config.WebSocket.AllowedOrigins)
+	wsBackend, err := socket.NewBackend(config.WebSocket.Broadcast, *logger, redisClient)
+	if err != nil {
+		logger.Error("failed to create websocket broadcast backend", "err", err)
+		return
+	}
+	wsPolicy, err := socket.ParseSlowConsumerPolicy(config.WebSocket.SlowConsumerPolicy)
+	if err != nil {
+		logger.Error("failed to read websocket slow consumer policy", "err", err)
+		return
+	}
+
+	runApp(logger, cacheClient, allRepos, sessions, bidWorker, probes, verifier, wsAuth, wsBackend, wsPolicy)
 }
 
-func runApp(logger *slog.Logger, cacheClient redis.CacheClients, allRepos postgres.Repositories) {
+func runApp(logger *slog.Logger, cacheClient redis.CacheClients, allRepos postgres.Repositories, sessions service.SessionServ,
+	bidWorker *worker.BidWorker, probes map[string]health.Probe, verifier identity.TokenVerifier, wsAuth *socket.Authenticator, wsBackend socket.Backend,
+	wsPolicy socket.SlowConsumerPolicy) {
 	/////// 1. Create a TCP listener on the specified port
 	listener, err := net.Listen("tcp", gRPCPortAddress)
 	if err != nil {
@@ -100,12 +151,54 @@ func runApp(logger *slog.Logger, cacheClient redis.CacheClients, allRepos postgr
 	g, gCtx := errgroup.WithContext(cancellableCtx)
 
 	/////// 2. Create a websocket hub and start it ---
-	hub := socket.NewHub(*logger)
+	hub := socket.NewHub(*logger, wsBackend, wsPolicy)
 	g.Go(func() error {
 		return hub.Run(gCtx)
 	})
 
-	/////// 3. start websocket server in a separate go routine
+	/////// 2.1 open, close and settle sessions at their start and end time
+	sessionEvents := socket.NewSessionEvents(hub, *logger)
+	lifecycle := service.NewSessionLifecycle(*logger, allRepos.SessionRepository, sessionEvents)
+	settlement := service.NewSessionSettlement(*logger, service.SettlementStores{
+		Sessions:    allRepos.SessionRepository,
+		Bids:        allRepos.BidRepository,
+		BidQueue:    cacheClient.BidClient,
+		Commitments: allRepos.CommitmentRepository,
+		Settlements: allRepos.SettlementRepository,
+	}, lifecycle, sessionEvents)
+	scheduler := worker.NewSessionScheduler(*logger, lifecycle, settlement, allRepos.SessionRepository, time.Second)
+	g.Go(func() error {
+		return scheduler.Run(gCtx)
+	})
+
+	/////// 2.2 push the DutchAuction clock prices to websocket subscribers
+	dutchClock := worker.NewDutchAuctionClock(*logger, hub, allRepos.SessionRepository, time.Second)
+	g.Go(func() error {
+		return dutchClock.Run(gCtx)
+	})
+
+	/////// 2.3 persist the placed bids queued in redis, the settlement reads them from postgres
+	g.Go(func() error {
+		return bidWorker.ProcessCachedBidsFromQueue(gCtx, redis.BidQueue)
+	})
+
+	/////// 2.4 probe the dependencies, a gRPC service stops serving while one of its dependencies fails
+	healthSrv := grpcHealth.NewServer()
+	healthChecker := health.NewChecker(*logger, healthSrv, probes, map[string][]string{
+		sessionV1.SessionService_ServiceDesc.ServiceName: {health.Postgres},
+		bidV1.BidService_ServiceDesc.ServiceName:         {health.Postgres, health.Redis},
+	}, 15*time.Second)
+	g.Go(func() error {
+		return healthChecker.Run(gCtx)
+	})
+
+	/////// 3. start the websocket and JSON gateway server in a separate go routine
+	gatewayHandler, err := gateway.NewGateway(gCtx, *logger, gRPCGatewayAddress)
+	if err != nil {
+		logger.Error("failed to create the JSON gateway", "err", err)
+		return
+	}
+
 	// TODO: IN production, use ListenAndServeTLS
 	server := &http.Server{
 		Addr: ":8082",
@@ -113,9 +206,14 @@ func runApp(logger *slog.Logger, cacheClient redis.CacheClients, allRepos postgr
 
 	g.Go(func() error {
 		http.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {
-			// TODO: Authenticate the user here before upgrading the connection.
-			socket.ServeWS(hub, w, r, *logger)
+			socket.ServeWS(hub, wsAuth, w, r, *logger)
 		})
+		// browsers can't set the user header on a websocket, they open it with a token issued here
+		http.HandleFunc("/ws/token", wsAuth.ServeToken)
+		// BidService and SessionService as JSON over HTTP, see proto google.api.http options for the routes
+		http.Handle(gateway.Prefix, gatewayHandler)
+		http.HandleFunc("/healthz", healthChecker.Liveness)
+		http.HandleFunc("/readyz", healthChecker.Readiness)
 
 		logger.Info("starting websocket http server on port 8082")
 		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
@@ -126,7 +224,7 @@ func runApp(logger *slog.Logger, cacheClient redis.CacheClients, allRepos postgr
 	})
 
 	//////// 4. start the gRPC server in a go routine
-	grpcServer, err := grpc.NewGRPCSrv(*logger, cacheClient, allRepos, hub)
+	grpcServer, err := grpc.NewGRPCSrv(*logger, cacheClient, allRepos, hub, sessions, lifecycle, settlement, healthSrv, verifier)
 	g.Go(func() error {
 		logger.Info("starting gRPC server", "port", gRPCPortAddress)
 		if err = grpcServer.Serve(listener); err != nil {
@@ -136,8 +234,6 @@ func runApp(logger *slog.Logger, cacheClient redis.CacheClients, allRepos postgr
 		return nil
 	})
 
-	healthBeatCheck(*logger)
-
 	// gracefully shut down the application if one of the servers fails, i.e., when the context is canceled.
 	g.Go(func() error {
 		<-cancellableCtx.Done() // Block until the context is canceled.
@@ -147,6 +243,17 @@ func runApp(logger *slog.Logger, cacheClient redis.CacheClients, allRepos postgr
 		if err := server.Shutdown(shutdownCtx); err != nil {
 			logger.Error("failed to shutdown xrf197ilz35aq", "err", err)
 		}
+		// long-lived streams, e.g., followed bids, are cut once the shutdown timeout is over
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-shutdownCtx.Done():
+			grpcServer.Stop()
+		}
 		return nil
 	})
 
@@ -202,9 +309,3 @@ func setTimescaleDB(config *internal.Config, logger slog.Logger) (*storage.Times
 	}
 	return timescalePool, nil
 }
-
-func healthBeatCheck(logger slog.Logger) {
-	for range time.Tick(time.Second * 60) {
-		logger.Info("event=appHealthCheck", "message", "healthy")
-	}
-}
@@ -0,0 +1,75 @@
+package auction
+
+import (
+	"fmt"
+	"time"
+	"xrf197ilz35aq2/core/domain"
+
+	"github.com/shopspring/decimal"
+)
+
+// PriceTick is the DutchAuction clock price of a session, pushed to websocket subscribers every time the price drops.
+type PriceTick struct {
+	SessionId  string          `json:"sessionId"`
+	AssetId    string          `json:"assetId"`
+	Price      decimal.Decimal `json:"price"`
+	NextDropAt time.Time       `json:"nextDropAt"`
+}
+
+// DutchAuction (descending) starts the session at its StartingPrice and lowers the price by PriceStep every
+// PriceStepSeconds, never going below the ReservePrice. The first bid accepting the clock price wins at that price,
+// and the session closes.
+type DutchAuction struct{}
+
+// CurrentPrice is the clock price of the session at the given time.
+func (DutchAuction) CurrentPrice(session domain.Session, at time.Time) decimal.Decimal {
+	if !at.After(session.StartTime) || !session.PriceStep.IsPositive() || session.PriceStepSeconds <= 0 {
+		return session.StartingPrice
+	}
+	drops := int64(at.Sub(session.StartTime) / stepInterval(session))
+	price := session.StartingPrice.Sub(session.PriceStep.Mul(decimal.NewFromInt(drops)))
+	return decimal.Max(price, session.ReservePrice)
+}
+
+// NextDropAt is when the clock price of the session drops next after the given time.
+func (DutchAuction) NextDropAt(session domain.Session, at time.Time) time.Time {
+	if session.PriceStepSeconds <= 0 || at.Before(session.StartTime) {
+		return session.StartTime
+	}
+	interval := stepInterval(session)
+	drops := at.Sub(session.StartTime) / interval
+	return session.StartTime.Add((drops + 1) * interval)
+}
+
+// Tick is the clock price of the session at the given time.
+func (d DutchAuction) Tick(session domain.Session, at time.Time) PriceTick {
+	return PriceTick{
+		SessionId:  session.Id,
+		AssetId:    session.AssetId,
+		Price:      d.CurrentPrice(session, at),
+		NextDropAt: d.NextDropAt(session, at),
+	}
+}
+
+func (d DutchAuction) Evaluate(session domain.Session, bid domain.Bid) Result {
+	if session.CurrentHighestBid.IsPositive() {
+		return Result{
+			HighestBid: session.CurrentHighestBid,
+			Reason:     fmt.Sprintf("session %s was already sold at %s", session.Id, session.CurrentHighestBid),
+		}
+	}
+	if bid.Timestamp.Before(session.StartTime) || !bid.Timestamp.Before(session.EndTime) {
+		return Result{Reason: fmt.Sprintf("price clock of session %s is not running", session.Id)}
+	}
+
+	price := d.CurrentPrice(session, bid.Timestamp)
+	if bid.Amount.LessThan(price) {
+		return Result{Reason: fmt.Sprintf("bid amount %s does not accept the current price %s", bid.Amount, price)}
+	}
+	// the winner pays the clock price, not what they bid above it
+	return Result{Accepted: true, HighestBid: price, ClosesSession: true}
+}
+
+func stepInterval(session domain.Session) time.Duration {
+	return time.Duration(session.PriceStepSeconds) * time.Second
+}
@@ -0,0 +1,44 @@
+package auction
+
+import (
+	"xrf197ilz35aq2/core/domain"
+
+	"github.com/shopspring/decimal"
+)
+
+// Result is the outcome of evaluating a bid against the session it was placed in.
+type Result struct {
+	Accepted bool
+	Reason   string // why the bid was rejected, empty when accepted
+	// HighestBid is the session's current highest bid once the bid has been evaluated.
+	HighestBid decimal.Decimal
+	// ClosesSession is true when accepting the bid ends the session, e.g., the first accepted bid of a DutchAuction.
+	ClosesSession bool
+	// Units is the quantity an accepted bid takes from the session's inventory, e.g., a FixedPriceAuction purchase.
+	Units float64
+	// RemainingQuantity is the session's inventory left once the units of the accepted bid were taken.
+	RemainingQuantity float64
+}
+
+// Engine applies the bidding rules of an auction type to incoming bids.
+// Engines are stateless, the session passed in carries the state a bid is evaluated against.
+type Engine interface {
+	Evaluate(session domain.Session, bid domain.Bid) Result
+}
+
+// NewEngine returns the engine evaluating bids for the auctionType.
+// The bool is false for auction types whose bids are not decided when they are placed.
+func NewEngine(auctionType string) (Engine, bool) {
+	switch auctionType {
+	case domain.EnglishAuction:
+		return EnglishAuction{}, true
+	case domain.DutchAuction:
+		return DutchAuction{}, true
+	case domain.FixedPriceAuction:
+		return FixedPriceAuction{}, true
+	case domain.MultiUnitAuction:
+		return MultiUnitAuction{}, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,35 @@
+package auction
+
+import (
+	"fmt"
+	"xrf197ilz35aq2/core/domain"
+
+	"github.com/shopspring/decimal"
+)
+
+// EnglishAuction (ascending) accepts a bid only when it is at least the session's current highest bid plus the
+// session's bid increment amount. An accepted bid becomes the session's new highest bid.
+type EnglishAuction struct{}
+
+// MinimumBid is the lowest amount the next bid in the session must have to be accepted.
+func (EnglishAuction) MinimumBid(session domain.Session) decimal.Decimal {
+	return session.CurrentHighestBid.Add(session.BidIncrementAmount)
+}
+
+func (e EnglishAuction) Evaluate(session domain.Session, bid domain.Bid) Result {
+	if bid.Timestamp.After(session.EndTime) {
+		return Result{
+			HighestBid: session.CurrentHighestBid,
+			Reason:     fmt.Sprintf("session %s ended at %s", session.Id, session.EndTime),
+		}
+	}
+
+	minimumBid := e.MinimumBid(session)
+	if bid.Amount.LessThan(minimumBid) {
+		return Result{
+			HighestBid: session.CurrentHighestBid,
+			Reason:     fmt.Sprintf("bid amount %s is below the minimum accepted bid %s", bid.Amount, minimumBid),
+		}
+	}
+	return Result{Accepted: true, HighestBid: bid.Amount}
+}
@@ -0,0 +1,32 @@
+package auction
+
+import (
+	"fmt"
+	"xrf197ilz35aq2/core/domain"
+)
+
+// FixedPriceAuction sells the session's inventory at its UnitPrice, no bidding involved. A bid is a purchase of its
+// Quantity units and is confirmed right away as long as enough units are available.
+type FixedPriceAuction struct{}
+
+func (FixedPriceAuction) Evaluate(session domain.Session, bid domain.Bid) Result {
+	if bid.Timestamp.Before(session.StartTime) || !bid.Timestamp.Before(session.EndTime) {
+		return Result{HighestBid: session.UnitPrice, Reason: fmt.Sprintf("session %s is not selling", session.Id)}
+	}
+	if bid.Quantity <= 0 {
+		return Result{HighestBid: session.UnitPrice, Reason: fmt.Sprintf("quantity %f is not a valid quantity", bid.Quantity)}
+	}
+	if bid.Amount.LessThan(session.UnitPrice) {
+		return Result{
+			HighestBid: session.UnitPrice,
+			Reason:     fmt.Sprintf("bid amount %s is below the unit price %s", bid.Amount, session.UnitPrice),
+		}
+	}
+	if bid.Quantity > session.AvailableQuantity {
+		return Result{
+			HighestBid: session.UnitPrice,
+			Reason:     fmt.Sprintf("only %f units are available, %f were requested", session.AvailableQuantity, bid.Quantity),
+		}
+	}
+	return Result{Accepted: true, HighestBid: session.UnitPrice, Units: bid.Quantity}
+}
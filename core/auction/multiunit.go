@@ -0,0 +1,79 @@
+package auction
+
+import (
+	"fmt"
+	"sort"
+	"xrf197ilz35aq2/core/domain"
+
+	"github.com/shopspring/decimal"
+)
+
+// MultiUnitAuction sells the session's AvailableQuantity units to the bids with the highest unit prices. A bid is a
+// unit price (its Amount) for a Quantity. Bids are accepted while the session runs and cleared once it ended: units are
+// allocated by unit price, earlier bids first on equal prices, and the lowest winning bid may get fewer units than it
+// asked for. Winners pay according to the session's PricingRule.
+type MultiUnitAuction struct{}
+
+func (MultiUnitAuction) Evaluate(session domain.Session, bid domain.Bid) Result {
+	highestBid := decimal.Max(session.CurrentHighestBid, bid.Amount)
+	if bid.Timestamp.Before(session.StartTime) || !bid.Timestamp.Before(session.EndTime) {
+		return Result{HighestBid: session.CurrentHighestBid, Reason: fmt.Sprintf("session %s is not running", session.Id)}
+	}
+	if bid.Quantity <= 0 {
+		return Result{HighestBid: session.CurrentHighestBid, Reason: fmt.Sprintf("quantity %f is not a valid quantity", bid.Quantity)}
+	}
+	if bid.Quantity > session.AvailableQuantity {
+		return Result{
+			HighestBid: session.CurrentHighestBid,
+			Reason:     fmt.Sprintf("only %f units are on offer, %f were requested", session.AvailableQuantity, bid.Quantity),
+		}
+	}
+	if bid.Amount.LessThan(session.ReservePrice) {
+		return Result{
+			HighestBid: session.CurrentHighestBid,
+			Reason:     fmt.Sprintf("unit price %s is below the reserve price %s", bid.Amount, session.ReservePrice),
+		}
+	}
+	return Result{Accepted: true, HighestBid: highestBid}
+}
+
+// Clear allocates the session's units to its accepted bids and prices the awards with the session's PricingRule.
+func (MultiUnitAuction) Clear(session domain.Session, bids []domain.Bid) []domain.Award {
+	ranked := make([]domain.Bid, 0, len(bids))
+	for _, bid := range bids {
+		if bid.Status == domain.AcceptedBid && bid.Quantity > 0 {
+			ranked = append(ranked, bid)
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if !ranked[i].Amount.Equal(ranked[j].Amount) {
+			return ranked[i].Amount.GreaterThan(ranked[j].Amount)
+		}
+		return ranked[i].Timestamp.Before(ranked[j].Timestamp)
+	})
+
+	awards := make([]domain.Award, 0)
+	remaining := session.AvailableQuantity
+	for _, bid := range ranked {
+		if remaining <= 0 {
+			break
+		}
+		units := min(bid.Quantity, remaining)
+		remaining -= units
+		awards = append(awards, domain.Award{
+			BidId:     bid.Id,
+			UserFp:    bid.UserFp,
+			BidAmount: bid.Amount,
+			Price:     bid.Amount,
+			Quantity:  units,
+		})
+	}
+
+	if session.PricingRule != domain.DiscriminatoryPricing && len(awards) > 0 {
+		clearingPrice := awards[len(awards)-1].BidAmount
+		for i := range awards {
+			awards[i].Price = clearingPrice
+		}
+	}
+	return awards
+}
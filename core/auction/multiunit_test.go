@@ -0,0 +1,111 @@
+package auction
+
+import (
+	"testing"
+	"time"
+	"xrf197ilz35aq2/core/domain"
+
+	"github.com/shopspring/decimal"
+)
+
+func unitBid(id string, unitPrice string, quantity float64, placedAt time.Time) domain.Bid {
+	return domain.Bid{
+		Id:        id,
+		UserFp:    "user-" + id,
+		Status:    domain.AcceptedBid,
+		Amount:    decimal.RequireFromString(unitPrice),
+		Quantity:  quantity,
+		Timestamp: placedAt,
+	}
+}
+
+type wantAward struct {
+	bidId    string
+	price    string
+	quantity float64
+}
+
+func TestMultiUnitClear(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	rejected := unitBid("rejected", "100", 5, start)
+	rejected.Status = domain.RejectedBid
+
+	tests := []struct {
+		name        string
+		quantity    float64
+		pricingRule string
+		bids        []domain.Bid
+		want        []wantAward
+	}{
+		{
+			name:        "no bids",
+			quantity:    10,
+			pricingRule: domain.UniformPricing,
+			want:        []wantAward{},
+		},
+		{
+			name:        "uniform pays the lowest winning unit price",
+			quantity:    10,
+			pricingRule: domain.UniformPricing,
+			bids:        []domain.Bid{unitBid("a", "10", 4, start), unitBid("b", "12", 4, start), unitBid("c", "8", 4, start)},
+			want:        []wantAward{{"b", "8", 4}, {"a", "8", 4}, {"c", "8", 2}},
+		},
+		{
+			name:        "discriminatory pays as bid",
+			quantity:    10,
+			pricingRule: domain.DiscriminatoryPricing,
+			bids:        []domain.Bid{unitBid("a", "10", 4, start), unitBid("b", "12", 4, start), unitBid("c", "8", 4, start)},
+			want:        []wantAward{{"b", "12", 4}, {"a", "10", 4}, {"c", "8", 2}},
+		},
+		{
+			name:        "bids beyond the units on offer lose",
+			quantity:    5,
+			pricingRule: domain.UniformPricing,
+			bids:        []domain.Bid{unitBid("a", "10", 5, start), unitBid("b", "9", 1, start)},
+			want:        []wantAward{{"a", "10", 5}},
+		},
+		{
+			name:        "equal unit prices go to the earlier bid",
+			quantity:    3,
+			pricingRule: domain.DiscriminatoryPricing,
+			bids:        []domain.Bid{unitBid("late", "10", 2, start.Add(time.Second)), unitBid("early", "10", 2, start)},
+			want:        []wantAward{{"early", "10", 2}, {"late", "10", 1}},
+		},
+		{
+			name:        "only accepted bids are cleared",
+			quantity:    5,
+			pricingRule: domain.UniformPricing,
+			bids:        []domain.Bid{rejected, unitBid("a", "7", 2, start)},
+			want:        []wantAward{{"a", "7", 2}},
+		},
+		{
+			name:        "undersubscribed sessions award every bid",
+			quantity:    100,
+			pricingRule: domain.UniformPricing,
+			bids:        []domain.Bid{unitBid("a", "10", 1, start), unitBid("b", "11", 2, start)},
+			want:        []wantAward{{"b", "10", 2}, {"a", "10", 1}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			session := domain.Session{
+				ActionType:        domain.MultiUnitAuction,
+				AvailableQuantity: test.quantity,
+				PricingRule:       test.pricingRule,
+			}
+			awards := MultiUnitAuction{}.Clear(session, test.bids)
+			if len(awards) != len(test.want) {
+				t.Fatalf("got %d awards %+v, want %d", len(awards), awards, len(test.want))
+			}
+			for i, want := range test.want {
+				award := awards[i]
+				if award.BidId != want.bidId || award.Quantity != want.quantity {
+					t.Errorf("award %d = %s x %f, want %s x %f", i, award.BidId, award.Quantity, want.bidId, want.quantity)
+				}
+				if price := decimal.RequireFromString(want.price); !award.Price.Equal(price) {
+					t.Errorf("award %d price = %s, want %s", i, award.Price, price)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package auction
+
+import (
+	"sort"
+	"xrf197ilz35aq2/core/domain"
+
+	"github.com/shopspring/decimal"
+)
+
+// ProxyPlacement is a bid to place on behalf of a proxy bid.
+type ProxyPlacement struct {
+	Proxy  domain.ProxyBid
+	Amount decimal.Decimal
+}
+
+// ResolveProxies decides the bids the session's proxy bids place against its current state, in the order they are to
+// be placed. Proxies are ranked by maximum, the earlier proxy first on equal maxima, so competing proxies always resolve
+// the same way: the top proxy leads at the runner-up's maximum plus the session's increment, capped at its own maximum.
+// Nothing is placed when the top proxy already leads unchallenged or no proxy can reach the session's minimum bid.
+func (e EnglishAuction) ResolveProxies(session domain.Session, proxies []domain.ProxyBid) []ProxyPlacement {
+	ranked := rankProxies(proxies)
+	minimumBid := e.MinimumBid(session)
+	if len(ranked) == 0 || ranked[0].MaxAmount.LessThan(minimumBid) {
+		return nil
+	}
+
+	top := ranked[0]
+	if len(ranked) == 1 || ranked[1].MaxAmount.LessThan(minimumBid) {
+		if top.UserFp == session.HighestBidderFp {
+			return nil
+		}
+		return []ProxyPlacement{{Proxy: top, Amount: minimumBid}}
+	}
+
+	runner := ranked[1]
+	outbid := runner.MaxAmount.Add(session.BidIncrementAmount)
+	if outbid.GreaterThan(top.MaxAmount) {
+		// the top proxy can't beat the runner-up's maximum by a full increment, it bids its maximum and the runner-up,
+		// unable to outbid that, places nothing
+		return []ProxyPlacement{{Proxy: top, Amount: top.MaxAmount}}
+	}
+	return []ProxyPlacement{
+		{Proxy: runner, Amount: runner.MaxAmount},
+		{Proxy: top, Amount: outbid},
+	}
+}
+
+func rankProxies(proxies []domain.ProxyBid) []domain.ProxyBid {
+	ranked := make([]domain.ProxyBid, len(proxies))
+	copy(ranked, proxies)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if !ranked[i].MaxAmount.Equal(ranked[j].MaxAmount) {
+			return ranked[i].MaxAmount.GreaterThan(ranked[j].MaxAmount)
+		}
+		if !ranked[i].CreatedAt.Equal(ranked[j].CreatedAt) {
+			return ranked[i].CreatedAt.Before(ranked[j].CreatedAt)
+		}
+		return ranked[i].Id < ranked[j].Id
+	})
+	return ranked
+}
@@ -0,0 +1,38 @@
+package auction
+
+import (
+	"fmt"
+	"time"
+	"xrf197ilz35aq2/core/domain"
+)
+
+// CanRetract decides whether the bidder may retract the bid at the given time, it returns why not otherwise.
+// Bids are only retracted while their session runs, and:
+//   - EnglishAuction: the leading bid only in the first half of the session, other bids anytime.
+//   - MultiUnitAuction: anytime, bids are only cleared once the session ended.
+//   - DutchAuction and FixedPriceAuction: never, the accepted bid is a sale.
+//   - Sealed auctions: never, a committed bid is replaced by committing again before the session ends.
+func CanRetract(session domain.Session, bid domain.Bid, at time.Time) error {
+	if bid.Status != domain.AcceptedBid && bid.Status != domain.PendingBid {
+		return fmt.Errorf("bid %s is %s, only pending or accepted bids can be retracted", bid.Id, bid.Status)
+	}
+	if session.Status != domain.ActiveSession || !at.Before(session.EndTime) {
+		return fmt.Errorf("session %s is not running", session.Id)
+	}
+
+	switch session.ActionType {
+	case domain.EnglishAuction:
+		if session.HighestBidId != bid.Id {
+			return nil
+		}
+		halfTime := session.StartTime.Add(session.EndTime.Sub(session.StartTime) / 2)
+		if !at.Before(halfTime) {
+			return fmt.Errorf("the leading bid can only be retracted before %s", halfTime)
+		}
+		return nil
+	case domain.MultiUnitAuction:
+		return nil
+	default:
+		return fmt.Errorf("bids of %s sessions can't be retracted", session.ActionType)
+	}
+}
@@ -0,0 +1,88 @@
+package auction
+
+import (
+	"sort"
+	"xrf197ilz35aq2/core/domain"
+
+	"github.com/shopspring/decimal"
+)
+
+// Clearing is the outcome of a sealed auction, the winning reveal, its bid and the price the winner pays.
+type Clearing struct {
+	Winner        domain.BidCommitment
+	WinningBid    decimal.Decimal
+	ClearingPrice decimal.Decimal
+}
+
+// SealedClearer decides a sealed auction from the bids revealed once the session ended.
+type SealedClearer interface {
+	// Clear returns the clearing of the session, the bool is false when no revealed bid meets the reserve price.
+	Clear(commitments []domain.BidCommitment, reservePrice decimal.Decimal) (*Clearing, bool)
+}
+
+// NewSealedClearer returns the clearer of the sealed auctionType, the bool is false when the type isn't sealed.
+func NewSealedClearer(auctionType string) (SealedClearer, bool) {
+	switch auctionType {
+	case domain.SealedAuction, domain.FirstPriceSealedAuction:
+		return SealedAuction{}, true
+	case domain.SecondPriceSealedAuction:
+		return SecondPriceSealedAuction{}, true
+	default:
+		return nil, false
+	}
+}
+
+// SealedAuction decides SealedAuction and FirstPriceSealedAuction sessions. Bids are committed sealed while the session
+// runs and only count once validly revealed. The highest revealed bid wins and pays its own bid.
+type SealedAuction struct{}
+
+// Winner returns the highest validly revealed bid, ties go to the bid committed first.
+// The bool is false when no bid was revealed.
+func (SealedAuction) Winner(commitments []domain.BidCommitment) (*domain.BidCommitment, bool) {
+	ranked := rankReveals(commitments)
+	if len(ranked) == 0 {
+		return nil, false
+	}
+	return &ranked[0], true
+}
+
+func (s SealedAuction) Clear(commitments []domain.BidCommitment, reservePrice decimal.Decimal) (*Clearing, bool) {
+	winner, ok := s.Winner(commitments)
+	if !ok || winner.Amount.LessThan(reservePrice) {
+		return nil, false
+	}
+	return &Clearing{Winner: *winner, WinningBid: winner.Amount, ClearingPrice: winner.Amount}, true
+}
+
+// SecondPriceSealedAuction (Vickrey) decides sessions like a SealedAuction, except the winner pays the second-highest
+// revealed bid, or the reserve price when that is higher.
+type SecondPriceSealedAuction struct{}
+
+func (SecondPriceSealedAuction) Clear(commitments []domain.BidCommitment, reservePrice decimal.Decimal) (*Clearing, bool) {
+	ranked := rankReveals(commitments)
+	if len(ranked) == 0 || ranked[0].Amount.LessThan(reservePrice) {
+		return nil, false
+	}
+	clearingPrice := reservePrice
+	if len(ranked) > 1 && ranked[1].Amount.GreaterThan(clearingPrice) {
+		clearingPrice = ranked[1].Amount
+	}
+	return &Clearing{Winner: ranked[0], WinningBid: ranked[0].Amount, ClearingPrice: clearingPrice}, true
+}
+
+// rankReveals orders the validly revealed bids from highest to lowest, ties go to the bid committed first.
+func rankReveals(commitments []domain.BidCommitment) []domain.BidCommitment {
+	ranked := make([]domain.BidCommitment, 0, len(commitments))
+	for _, commitment := range commitments {
+		if commitment.Status == domain.RevealedBid {
+			ranked = append(ranked, commitment)
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if !ranked[i].Amount.Equal(ranked[j].Amount) {
+			return ranked[i].Amount.GreaterThan(ranked[j].Amount)
+		}
+		return ranked[i].CommittedAt.Before(ranked[j].CommittedAt)
+	})
+	return ranked
+}
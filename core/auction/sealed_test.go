@@ -0,0 +1,148 @@
+package auction
+
+import (
+	"testing"
+	"time"
+	"xrf197ilz35aq2/core/domain"
+
+	"github.com/shopspring/decimal"
+)
+
+func reveal(id string, amount string, committedAt time.Time) domain.BidCommitment {
+	return domain.BidCommitment{
+		Id:          id,
+		Status:      domain.RevealedBid,
+		Amount:      decimal.RequireFromString(amount),
+		CommittedAt: committedAt,
+	}
+}
+
+func TestSealedClear(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	unrevealed := reveal("unrevealed", "500", start)
+	unrevealed.Status = domain.CommittedBid
+
+	tests := []struct {
+		name        string
+		auctionType string
+		commitments []domain.BidCommitment
+		reserve     string
+		cleared     bool
+		winner      string
+		price       string
+	}{
+		{
+			name:        "no reveals",
+			auctionType: domain.SealedAuction,
+			commitments: []domain.BidCommitment{unrevealed},
+			reserve:     "0",
+		},
+		{
+			name:        "first price pays its own bid",
+			auctionType: domain.FirstPriceSealedAuction,
+			commitments: []domain.BidCommitment{reveal("a", "100", start), reveal("b", "150", start.Add(time.Second))},
+			reserve:     "0",
+			cleared:     true,
+			winner:      "b",
+			price:       "150",
+		},
+		{
+			name:        "unrevealed bids don't count",
+			auctionType: domain.SealedAuction,
+			commitments: []domain.BidCommitment{unrevealed, reveal("a", "100", start)},
+			reserve:     "0",
+			cleared:     true,
+			winner:      "a",
+			price:       "100",
+		},
+		{
+			name:        "tie goes to the first commitment",
+			auctionType: domain.SealedAuction,
+			commitments: []domain.BidCommitment{reveal("late", "100", start.Add(time.Second)), reveal("early", "100", start)},
+			reserve:     "0",
+			cleared:     true,
+			winner:      "early",
+			price:       "100",
+		},
+		{
+			name:        "first price below the reserve",
+			auctionType: domain.SealedAuction,
+			commitments: []domain.BidCommitment{reveal("a", "100", start)},
+			reserve:     "101",
+		},
+		{
+			name:        "second price pays the second-highest bid",
+			auctionType: domain.SecondPriceSealedAuction,
+			commitments: []domain.BidCommitment{reveal("a", "100", start), reveal("b", "150", start), reveal("c", "120", start)},
+			reserve:     "50",
+			cleared:     true,
+			winner:      "b",
+			price:       "120",
+		},
+		{
+			name:        "second price pays the reserve when higher",
+			auctionType: domain.SecondPriceSealedAuction,
+			commitments: []domain.BidCommitment{reveal("a", "100", start), reveal("b", "150", start)},
+			reserve:     "130",
+			cleared:     true,
+			winner:      "b",
+			price:       "130",
+		},
+		{
+			name:        "second price with a single bid pays the reserve",
+			auctionType: domain.SecondPriceSealedAuction,
+			commitments: []domain.BidCommitment{reveal("a", "100", start)},
+			reserve:     "40",
+			cleared:     true,
+			winner:      "a",
+			price:       "40",
+		},
+		{
+			name:        "second price tie pays the tied bid",
+			auctionType: domain.SecondPriceSealedAuction,
+			commitments: []domain.BidCommitment{reveal("late", "100", start.Add(time.Second)), reveal("early", "100", start)},
+			reserve:     "0",
+			cleared:     true,
+			winner:      "early",
+			price:       "100",
+		},
+		{
+			name:        "second price below the reserve",
+			auctionType: domain.SecondPriceSealedAuction,
+			commitments: []domain.BidCommitment{reveal("a", "100", start), reveal("b", "90", start)},
+			reserve:     "150",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clearer, ok := NewSealedClearer(test.auctionType)
+			if !ok {
+				t.Fatalf("no sealed clearer for %s", test.auctionType)
+			}
+			clearing, cleared := clearer.Clear(test.commitments, decimal.RequireFromString(test.reserve))
+			if cleared != test.cleared {
+				t.Fatalf("cleared = %t, want %t", cleared, test.cleared)
+			}
+			if !cleared {
+				return
+			}
+			if clearing.Winner.Id != test.winner {
+				t.Errorf("winner = %s, want %s", clearing.Winner.Id, test.winner)
+			}
+			if !clearing.WinningBid.Equal(clearing.Winner.Amount) {
+				t.Errorf("winning bid = %s, want %s", clearing.WinningBid, clearing.Winner.Amount)
+			}
+			if price := decimal.RequireFromString(test.price); !clearing.ClearingPrice.Equal(price) {
+				t.Errorf("clearing price = %s, want %s", clearing.ClearingPrice, price)
+			}
+		})
+	}
+}
+
+func TestNewSealedClearer(t *testing.T) {
+	for _, auctionType := range []string{domain.EnglishAuction, domain.MultiUnitAuction} {
+		if _, ok := NewSealedClearer(auctionType); ok {
+			t.Errorf("%s has a sealed clearer", auctionType)
+		}
+	}
+}
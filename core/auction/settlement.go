@@ -0,0 +1,57 @@
+package auction
+
+import "xrf197ilz35aq2/core/domain"
+
+// Decide determines the winning bids of an ended session. The bool is false when the reserve price isn't met.
+// bids are the session's bids and commitments its sealed bids, only the ones the session's auction type uses are read.
+func Decide(session domain.Session, bids []domain.Bid, commitments []domain.BidCommitment) ([]domain.Award, bool) {
+	switch session.ActionType {
+	case domain.EnglishAuction, domain.DutchAuction:
+		// the session tracks its leading bid, for a DutchAuction it's the bid that accepted the clock price
+		if session.HighestBidId == "" {
+			return nil, false
+		}
+		award := domain.Award{
+			Quantity:  1,
+			BidId:     session.HighestBidId,
+			UserFp:    session.HighestBidderFp,
+			BidAmount: session.CurrentHighestBid,
+			Price:     session.CurrentHighestBid,
+		}
+		return []domain.Award{award}, session.CurrentHighestBid.GreaterThanOrEqual(session.ReservePrice)
+	case domain.FixedPriceAuction:
+		awards := make([]domain.Award, 0)
+		for _, bid := range bids {
+			if bid.Status != domain.AcceptedBid {
+				continue
+			}
+			awards = append(awards, domain.Award{
+				BidId:     bid.Id,
+				UserFp:    bid.UserFp,
+				BidAmount: bid.Amount,
+				Price:     session.UnitPrice,
+				Quantity:  bid.Quantity,
+			})
+		}
+		return awards, true
+	case domain.MultiUnitAuction:
+		return MultiUnitAuction{}.Clear(session, bids), true
+	default:
+		clearer, ok := NewSealedClearer(session.ActionType)
+		if !ok {
+			return nil, false
+		}
+		clearing, ok := clearer.Clear(commitments, session.ReservePrice)
+		if !ok {
+			return nil, false
+		}
+		award := domain.Award{
+			Quantity:  1,
+			BidId:     clearing.Winner.BidId,
+			UserFp:    clearing.Winner.UserFp,
+			BidAmount: clearing.WinningBid,
+			Price:     clearing.ClearingPrice,
+		}
+		return []domain.Award{award}, true
+	}
+}
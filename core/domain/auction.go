@@ -7,11 +7,30 @@ package domain
 // **SealedAuction** Bidders submit their bids privately, without knowing others' bids. At the end of the bidding
 // period, the bids are revealed, and the highest bidder wins
 // **FirstPriceSealedAuction**: Highest bidder wins and pays their bid.
+// **SecondPriceSealedAuction** (Vickrey): Highest bidder wins and pays the second-highest bid, or the reserve price if
+// that is higher. Bidding one's true value is the best strategy.
 // **FixedPriceAuction** Asset is sold at a set price, no bidding involved.
+// **MultiUnitAuction** A lot of fungible units is on offer, bidders bid a unit price for a quantity. Once the session
+// ended, the units go to the highest unit prices, the lowest winning bid may be partially filled. Winners pay according to
+// the session's PricingRule.
 const (
-	DutchAuction            = "DutchAuction"
-	SealedAuction           = "SealedAuction"
-	EnglishAuction          = "EnglishAuction" // The new bid must be higher than the current highest bid bid_increment_amount
-	FixedPriceAuction       = "FixedPriceAuction"
-	FirstPriceSealedAuction = "FirstPriceSealedAuction"
+	DutchAuction             = "DutchAuction"
+	SealedAuction            = "SealedAuction"
+	EnglishAuction           = "EnglishAuction" // The new bid must be higher than the current highest bid bid_increment_amount
+	FixedPriceAuction        = "FixedPriceAuction"
+	FirstPriceSealedAuction  = "FirstPriceSealedAuction"
+	SecondPriceSealedAuction = "SecondPriceSealedAuction"
+	MultiUnitAuction         = "MultiUnitAuction"
 )
+
+// Pricing rules of a MultiUnitAuction.
+// **UniformPricing** All winners pay the lowest winning unit price.
+// **DiscriminatoryPricing** (pay-as-bid) Every winner pays the unit price they bid.
+const (
+	UniformPricing        = "UNIFORM"
+	DiscriminatoryPricing = "DISCRIMINATORY"
+)
+
+func IsValidPricingRule(pricingRule string) bool {
+	return pricingRule == UniformPricing || pricingRule == DiscriminatoryPricing
+}
@@ -2,37 +2,44 @@ package domain
 
 import (
 	"crypto/rand"
-	"fmt"
 	"math/big"
 	"strconv"
 	"sync"
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 const (
-	PendingBid  = "PENDING"
-	RejectedBid = "REJECTED"
-	AcceptedBid = "ACCEPTED"
+	PendingBid   = "PENDING"
+	RejectedBid  = "REJECTED"
+	AcceptedBid  = "ACCEPTED"
+	WonBid       = "WON"       // the bid won the session once it was settled
+	LostBid      = "LOST"      // the bid was outbid when the session was settled
+	CancelledBid = "CANCELLED" // the bidder retracted the bid
 )
 
 type Bid struct {
-	Id         string    `json:"bidId" db:"id"`
-	AssetOwner string    `json:"_" db:"seller_fp"`
-	Amount     float64   `json:"amount" db:"amount"`
-	Quantity   float64   `json:"quantity" db:"quantity"`
-	AssetId    string    `json:"assetId" db:"asset_id"`
-	Status     string    `json:"status" db:"bid_status"`
-	Timestamp  time.Time `json:"timestamp" db:"bid_time"`
-	UserFp     string    `json:"placedBy" db:"bidder_fp"`
-	Accepted   bool      `json:"accepted" db:"is_accepted"`
-	SessionId  string    `json:"sessionId" db:"session_id"`
-	LastUntil  time.Time `json:"lastUntil" db:"expiration_time"`
+	Id         string          `json:"bidId" db:"id"`
+	AssetOwner string          `json:"_" db:"seller_fp"`
+	Amount     decimal.Decimal `json:"amount" db:"amount"`
+	Quantity   float64         `json:"quantity" db:"quantity"`
+	AssetId    string          `json:"assetId" db:"asset_id"`
+	Status     string          `json:"status" db:"bid_status"`
+	Timestamp  time.Time       `json:"timestamp" db:"bid_time"`
+	UserFp     string          `json:"placedBy" db:"bidder_fp"`
+	Accepted   bool            `json:"accepted" db:"is_accepted"`
+	SessionId  string          `json:"sessionId" db:"session_id"`
+	LastUntil  time.Time       `json:"lastUntil" db:"expiration_time"`
 }
 
-func NewBid(userFp string, amount float64, assetId string, lastUntil time.Time, sessionId string) (*Bid, error) {
+func NewBid(userFp string, amount decimal.Decimal, assetId string, lastUntil time.Time, sessionId string) (*Bid, error) {
 	now := time.Now()
+	if !amount.IsPositive() {
+		return nil, fieldError("amount", "amount %s is not a valid bid amount", amount)
+	}
 	if isNotValidLastingTime(lastUntil) {
-		return nil, fmt.Errorf("lasting time %s is not a valid lasting time", lastUntil)
+		return nil, fieldError("last_until", "lasting time %s is not a valid lasting time", lastUntil)
 	}
 	return &Bid{
 		Timestamp: now,
@@ -47,6 +54,34 @@ func NewBid(userFp string, amount float64, assetId string, lastUntil time.Time,
 	}, nil
 }
 
+// Accept marks the bid as accepted by the auction it was placed in.
+func (b *Bid) Accept() {
+	b.Accepted = true
+	b.Status = AcceptedBid
+}
+
+// Reject marks the bid as rejected by the auction it was placed in.
+func (b *Bid) Reject() {
+	b.Accepted = false
+	b.Status = RejectedBid
+}
+
+// Cancel marks the bid as retracted by its bidder.
+func (b *Bid) Cancel() {
+	b.Accepted = false
+	b.Status = CancelledBid
+}
+
+// BidRetraction records a bid retracted by its bidder and the session's highest bid once it was retracted, it's pushed
+// to websocket subscribers.
+type BidRetraction struct {
+	BidId             string          `json:"bidId"`
+	AssetId           string          `json:"assetId"`
+	SessionId         string          `json:"sessionId"`
+	Status            string          `json:"status"`
+	CurrentHighestBid decimal.Decimal `json:"currentHighestBid"`
+}
+
 func isNotValidLastingTime(lastUntil time.Time) bool {
 	now := time.Now()
 	return lastUntil.Before(now)
@@ -57,7 +92,7 @@ func IsValidBidStatus(status string) bool {
 		return false
 	}
 	bidStatuses := make([]string, 0)
-	bidStatuses = append(bidStatuses, PendingBid, RejectedBid, AcceptedBid)
+	bidStatuses = append(bidStatuses, PendingBid, RejectedBid, AcceptedBid, WonBid, LostBid, CancelledBid)
 
 	for _, bStatus := range bidStatuses {
 		if bStatus == status {
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	CommittedBid = "COMMITTED"
+	RevealedBid  = "REVEALED"
+)
+
+// BidCommitment is a sealed bid. While the session runs a bidder only submits a hash of their bid, the amount is revealed
+// once the session has ended and must match the hash for the bid to count.
+type BidCommitment struct {
+	Id          string          `json:"commitmentId" db:"id"`
+	UserFp      string          `json:"placedBy" db:"bidder_fp"`
+	AssetId     string          `json:"assetId" db:"asset_id"`
+	SessionId   string          `json:"sessionId" db:"session_id"`
+	Commitment  string          `json:"commitment" db:"commitment"` // hex encoded SHA-256, see CommitmentHash
+	Status      string          `json:"status" db:"status"`
+	CommittedAt time.Time       `json:"committedAt" db:"committed_at"`
+	BidId       string          `json:"bidId" db:"bid_id"` // the bid created from a valid reveal
+	Nonce       string          `json:"-" db:"nonce"`
+	Amount      decimal.Decimal `json:"amount" db:"amount"`
+	RevealedAt  *time.Time      `json:"revealedAt" db:"revealed_at"`
+}
+
+func NewBidCommitment(userFp string, assetId string, sessionId string, commitment string) (*BidCommitment, error) {
+	decoded, err := hex.DecodeString(commitment)
+	if err != nil || len(decoded) != sha256.Size {
+		return nil, fieldError("commitment", "commitment %s is not a hex encoded SHA-256 hash", commitment)
+	}
+	return &BidCommitment{
+		UserFp:      userFp,
+		AssetId:     assetId,
+		SessionId:   sessionId,
+		Commitment:  commitment,
+		Status:      CommittedBid,
+		CommittedAt: time.Now(),
+		Id:          strconv.FormatInt(generateId(), 10),
+	}, nil
+}
+
+// CommitmentHash is the hex encoded SHA-256 of "<userFp>:<amount>:<nonce>". The user fingerprint is part of the hash,
+// so a bidder can't copy another bidder's commitment.
+func CommitmentHash(userFp string, amount string, nonce string) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s:%s:%s", userFp, amount, nonce)))
+	return hex.EncodeToString(sum[:])
+}
+
+// Reveal opens the commitment with the bid amount and nonce it was created with.
+// amountText is the amount in the decimal form it was hashed with.
+func (c *BidCommitment) Reveal(amount decimal.Decimal, amountText string, nonce string, revealedAt time.Time) error {
+	if c.Status == RevealedBid {
+		return fmt.Errorf("commitment %s is already revealed", c.Id)
+	}
+	if !amount.IsPositive() {
+		return fieldError("amount", "amount %s is not a valid bid amount", amount)
+	}
+	if CommitmentHash(c.UserFp, amountText, nonce) != c.Commitment {
+		return fieldError("nonce", "revealed amount and nonce do not match commitment %s", c.Id)
+	}
+	c.Nonce = nonce
+	c.Amount = amount
+	c.Status = RevealedBid
+	c.RevealedAt = &revealedAt
+	return nil
+}
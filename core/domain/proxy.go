@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ProxyBid is a bidder's hidden maximum in an EnglishAuction session. Whenever the bidder is outbid, the minimum bid
+// needed to lead again is placed on their behalf, as long as it doesn't exceed the maximum. The maximum itself is never
+// shown to other bidders.
+type ProxyBid struct {
+	Id        string          `json:"proxyId" db:"id"`
+	UserFp    string          `json:"placedBy" db:"bidder_fp"`
+	AssetId   string          `json:"assetId" db:"asset_id"`
+	SessionId string          `json:"sessionId" db:"session_id"`
+	MaxAmount decimal.Decimal `json:"-" db:"max_amount"`
+	CreatedAt time.Time       `json:"createdAt" db:"created_at"`
+	UpdatedAt time.Time       `json:"updatedAt" db:"updated_at"`
+}
+
+func NewProxyBid(userFp string, assetId string, sessionId string, maxAmount decimal.Decimal) (*ProxyBid, error) {
+	if !maxAmount.IsPositive() {
+		return nil, fieldError("max_amount", "maximum amount %s is not a valid proxy bid maximum", maxAmount)
+	}
+	now := time.Now()
+	return &ProxyBid{
+		UserFp:    userFp,
+		AssetId:   assetId,
+		SessionId: sessionId,
+		MaxAmount: maxAmount,
+		CreatedAt: now,
+		UpdatedAt: now,
+		Id:        strconv.FormatInt(generateId(), 10),
+	}, nil
+}
@@ -5,6 +5,8 @@ import (
 	"strconv"
 	"time"
 	"xrf197ilz35aq2/internal/exchange"
+
+	"github.com/shopspring/decimal"
 )
 
 // Session captures the session for which bids can be placed on an asset. Think of it as an auction span.
@@ -12,22 +14,55 @@ import (
 // Bids and asks (offers) are placed and matched within a trading session.
 // This table provides a grouping for bids that belong to a specific bidding event for an asset.
 type Session struct {
-	Id                string    `json:"sessionId"  db:"id"`
-	UserFp            string    `json:"userFp"  db:"user_fp"`
-	Name              string    `json:"name" db:"session_name"`
-	AssetId           string    `json:"assetId"  db:"asset_id"`
-	CreatedAt         time.Time `json:"createdAt"  db:"created_at"`
-	EndTime           time.Time `json:"endTime"   db:"end_time"`
-	StartTime         time.Time `json:"startTime"   db:"start_time"`
-	Status            string    `json:"status" db:"session_status"` // ["Scheduled," "Active," "Closed," "Completed," "Cancelled."]
-	CurrentHighestBid float64   `json:"currentHighestBid"  db:"current_highest_bid"`
+	Id                string          `json:"sessionId"  db:"id"`
+	UserFp            string          `json:"userFp"  db:"user_fp"`
+	Name              string          `json:"name" db:"session_name"`
+	AssetId           string          `json:"assetId"  db:"asset_id"`
+	CreatedAt         time.Time       `json:"createdAt"  db:"created_at"`
+	EndTime           time.Time       `json:"endTime"   db:"end_time"`
+	StartTime         time.Time       `json:"startTime"   db:"start_time"`
+	Status            string          `json:"status" db:"session_status"` // ["Scheduled," "Active," "Closed," "Completed," "Cancelled."]
+	CurrentHighestBid decimal.Decimal `json:"currentHighestBid"  db:"current_highest_bid"`
+	HighestBidId      string          `json:"highestBidId" db:"highest_bid_id"`       // the bid leading the session
+	HighestBidderFp   string          `json:"highestBidderFp" db:"highest_bidder_fp"` // who placed the leading bid
 	// Defines the format/rules of the auction. Different auction types have different bidding mechanisms and strategies.
 	ActionType string `json:"auctionType"  db:"auction_type"`
 	// Allows asset owners to set a minimum value they are willing to accept
-	ReservePrice float64 `json:"reservePrice" db:"reserve_price"` // The minimum price the product must reach for a sale to occur.
-	AutoExecute  bool    `json:"autoExecute" db:"auto_execute"`   // Seal asset if true, and contract holds plus bis rules.
+	ReservePrice decimal.Decimal `json:"reservePrice" db:"reserve_price"` // The minimum price the product must reach for a sale to occur.
+	AutoExecute  bool            `json:"autoExecute" db:"auto_execute"`   // Seal asset if true, and contract holds plus bis rules.
 	// The bidIncrementAmount is the min amount by w/c a new bid must exceed the currentHighestBid. For EnglishAuction/ascending auctions
-	BidIncrementAmount float64 `json:"bidIncrementAmount" db:"bid_increment_amount"`
+	BidIncrementAmount decimal.Decimal `json:"bidIncrementAmount" db:"bid_increment_amount"`
+	// DutchAuction price clock. The price starts at StartingPrice and drops by PriceStep every PriceStepSeconds
+	// until it reaches the ReservePrice (the floor).
+	StartingPrice    decimal.Decimal `json:"startingPrice" db:"starting_price"`
+	PriceStep        decimal.Decimal `json:"priceStep" db:"price_step"`
+	PriceStepSeconds int64           `json:"priceStepSeconds" db:"price_step_seconds"`
+	// Sealed auctions. Bids are committed while the session runs and revealed within RevealSeconds after the EndTime.
+	RevealSeconds int64 `json:"revealSeconds" db:"reveal_seconds"`
+	// FixedPriceAuction. Units are sold at the UnitPrice until the AvailableQuantity is sold out.
+	// MultiUnitAuction. AvailableQuantity is the units on offer, cleared once the session ended with the PricingRule.
+	UnitPrice         decimal.Decimal `json:"unitPrice" db:"unit_price"`
+	AvailableQuantity float64         `json:"availableQuantity" db:"available_quantity"`
+	PricingRule       string          `json:"pricingRule" db:"pricing_rule"`
+	// Soft close. A bid accepted within the final SoftCloseSeconds extends the EndTime by ExtensionSeconds, until the
+	// extensions add up to MaxExtensionSeconds (no cap when zero). ExtendedSeconds is how much the session was extended.
+	SoftCloseSeconds    int64 `json:"softCloseSeconds" db:"soft_close_seconds"`
+	ExtensionSeconds    int64 `json:"extensionSeconds" db:"extension_seconds"`
+	MaxExtensionSeconds int64 `json:"maxExtensionSeconds" db:"max_extension_seconds"`
+	ExtendedSeconds     int64 `json:"extendedSeconds" db:"extended_seconds"`
+}
+
+// DefaultRevealSeconds is how long bidders of a sealed auction have to reveal their bids when the session doesn't say.
+const DefaultRevealSeconds int64 = 60 * 60
+
+// IsSealedAuction reports whether bids of the auctionType are committed sealed and revealed once the session ended.
+func IsSealedAuction(auctionType string) bool {
+	return auctionType == SealedAuction || auctionType == FirstPriceSealedAuction || auctionType == SecondPriceSealedAuction
+}
+
+// RevealEndTime is the end of the reveal phase of a sealed auction session.
+func (s Session) RevealEndTime() time.Time {
+	return s.EndTime.Add(time.Duration(s.RevealSeconds) * time.Second)
 }
 
 func IsValidAuctionType(auctionType string) bool {
@@ -35,7 +70,8 @@ func IsValidAuctionType(auctionType string) bool {
 		return false
 	}
 	auctionTypes := make([]string, 0)
-	auctionTypes = append(auctionTypes, EnglishAuction, DutchAuction, SealedAuction, FirstPriceSealedAuction, FixedPriceAuction)
+	auctionTypes = append(auctionTypes, EnglishAuction, DutchAuction, SealedAuction, FirstPriceSealedAuction,
+		SecondPriceSealedAuction, FixedPriceAuction, MultiUnitAuction)
 	for _, aucType := range auctionTypes {
 		if aucType == auctionType {
 			return true
@@ -46,37 +82,156 @@ func IsValidAuctionType(auctionType string) bool {
 
 func NewSession(sessionReq exchange.NewSessionRequest, userFp string) (*Session, error) {
 	if valid := IsValidAuctionType(sessionReq.Type); !valid {
-		return nil, fmt.Errorf("invalid auction type %s", sessionReq.Type)
+		return nil, fieldError("auction_type", "invalid auction type %s", sessionReq.Type)
 	}
 	if sessionReq.EndTime.Before(sessionReq.StartTime) {
-		return nil, fmt.Errorf("end time %s is before start time %s", sessionReq.EndTime, sessionReq.StartTime)
+		return nil, fieldError("end_time", "end time %s is before start time %s", sessionReq.EndTime, sessionReq.StartTime)
+	}
+	if sessionReq.BidIncrementAmount.IsNegative() {
+		return nil, fieldError("bid_increment_amount", "bid increment amount %s is not a valid bid increment amount", sessionReq.BidIncrementAmount)
+	}
+	// only English auctions outbid by the increment, a bid must raise the highest bid
+	if sessionReq.Type == EnglishAuction && !sessionReq.BidIncrementAmount.IsPositive() {
+		return nil, fieldError("bid_increment_amount", "%s sessions need a positive bid increment amount", EnglishAuction)
+	}
+	if sessionReq.ReservePrice.IsNegative() {
+		return nil, fieldError("reserve_price", "reserve price %s is not a valid reserve price", sessionReq.ReservePrice)
+	}
+
+	if sessionReq.Type == DutchAuction {
+		if err := validatePriceClock(sessionReq); err != nil {
+			return nil, err
+		}
 	}
-	if sessionReq.BidIncrementAmount < 0 {
-		return nil, fmt.Errorf("bid increment amount %f is not a valid bid increment amount", sessionReq.BidIncrementAmount)
+
+	if sessionReq.Type == FixedPriceAuction {
+		if !sessionReq.UnitPrice.IsPositive() {
+			return nil, fieldError("unit_price", "unit price %s is not a valid unit price", sessionReq.UnitPrice)
+		}
+		if sessionReq.AvailableQuantity <= 0 {
+			return nil, fieldError("available_quantity", "available quantity %f is not a valid quantity", sessionReq.AvailableQuantity)
+		}
 	}
-	if sessionReq.ReservePrice < 0 {
-		return nil, fmt.Errorf("reserve price %f is not a valid reserve price", sessionReq.ReservePrice)
+
+	if err := validateSoftClose(sessionReq); err != nil {
+		return nil, err
+	}
+
+	pricingRule := ""
+	if sessionReq.Type == MultiUnitAuction {
+		if sessionReq.AvailableQuantity <= 0 {
+			return nil, fieldError("available_quantity", "available quantity %f is not a valid quantity", sessionReq.AvailableQuantity)
+		}
+		pricingRule = sessionReq.PricingRule
+		if pricingRule == "" {
+			pricingRule = UniformPricing
+		}
+		if !IsValidPricingRule(pricingRule) {
+			return nil, fieldError("pricing_rule", "invalid pricing rule %s", sessionReq.PricingRule)
+		}
+	}
+
+	revealSeconds := int64(0)
+	if IsSealedAuction(sessionReq.Type) {
+		if sessionReq.RevealSeconds < 0 {
+			return nil, fieldError("reveal_seconds", "reveal seconds %d is not a valid reveal period", sessionReq.RevealSeconds)
+		}
+		revealSeconds = sessionReq.RevealSeconds
+		if revealSeconds == 0 {
+			revealSeconds = DefaultRevealSeconds
+		}
 	}
 
 	sessionId := generateId()
 	now := time.Now()
-	status := "Scheduled"
-	if sessionReq.StartTime.After(now) {
-		status = "Active"
+	if !sessionReq.EndTime.After(now) {
+		return nil, fieldError("end_time", "end time %s is not in the future", sessionReq.EndTime)
+	}
+	// sessions starting in the future are opened by the session scheduler at their start time
+	status := ScheduledSession
+	if !sessionReq.StartTime.After(now) {
+		status = ActiveSession
 	}
 	return &Session{
-		Id:                 strconv.FormatInt(sessionId, 10),
-		Status:             status,
-		CurrentHighestBid:  0.0,
-		CreatedAt:          now,
-		UserFp:             userFp,
-		ActionType:         sessionReq.Type,
-		Name:               sessionReq.Name,
-		EndTime:            sessionReq.EndTime,
-		AssetId:            sessionReq.AssetId,
-		StartTime:          sessionReq.StartTime,
-		AutoExecute:        sessionReq.AutoExecute,
-		ReservePrice:       sessionReq.ReservePrice,
-		BidIncrementAmount: sessionReq.BidIncrementAmount,
+		Id:                  strconv.FormatInt(sessionId, 10),
+		Status:              status,
+		CurrentHighestBid:   decimal.Zero,
+		CreatedAt:           now,
+		UserFp:              userFp,
+		ActionType:          sessionReq.Type,
+		Name:                sessionReq.Name,
+		EndTime:             sessionReq.EndTime,
+		AssetId:             sessionReq.AssetId,
+		StartTime:           sessionReq.StartTime,
+		AutoExecute:         sessionReq.AutoExecute,
+		ReservePrice:        sessionReq.ReservePrice,
+		BidIncrementAmount:  sessionReq.BidIncrementAmount,
+		StartingPrice:       sessionReq.StartingPrice,
+		PriceStep:           sessionReq.PriceStep,
+		PriceStepSeconds:    sessionReq.PriceStepSeconds,
+		RevealSeconds:       revealSeconds,
+		UnitPrice:           sessionReq.UnitPrice,
+		AvailableQuantity:   sessionReq.AvailableQuantity,
+		PricingRule:         pricingRule,
+		SoftCloseSeconds:    sessionReq.SoftCloseSeconds,
+		ExtensionSeconds:    sessionReq.ExtensionSeconds,
+		MaxExtensionSeconds: sessionReq.MaxExtensionSeconds,
 	}, nil
 }
+
+func validatePriceClock(sessionReq exchange.NewSessionRequest) error {
+	if !sessionReq.StartingPrice.IsPositive() {
+		return fieldError("starting_price", "starting price %s is not a valid starting price", sessionReq.StartingPrice)
+	}
+	if sessionReq.StartingPrice.LessThan(sessionReq.ReservePrice) {
+		return fieldError("starting_price", "starting price %s is below the reserve price %s", sessionReq.StartingPrice, sessionReq.ReservePrice)
+	}
+	if !sessionReq.PriceStep.IsPositive() {
+		return fieldError("price_step", "price step %s is not a valid price step", sessionReq.PriceStep)
+	}
+	if sessionReq.PriceStepSeconds <= 0 {
+		return fieldError("price_step_seconds", "price step interval %ds is not a valid interval", sessionReq.PriceStepSeconds)
+	}
+	return nil
+}
+
+// SessionUpdate is the settings of a scheduled session its owner can change, nil fields are left as they are.
+type SessionUpdate struct {
+	Name         *string
+	ReservePrice *decimal.Decimal
+	EndTime      *time.Time
+}
+
+// Update changes the settings of the session, only while it's Scheduled.
+func (s *Session) Update(update SessionUpdate, at time.Time) error {
+	if s.Status != ScheduledSession {
+		return fmt.Errorf("session %s is %s, only scheduled sessions can be updated", s.Id, s.Status)
+	}
+	if update.ReservePrice != nil {
+		if update.ReservePrice.IsNegative() {
+			return fieldError("reserve_price", "reserve price %s is not a valid reserve price", *update.ReservePrice)
+		}
+		if s.ActionType == DutchAuction && s.StartingPrice.LessThan(*update.ReservePrice) {
+			return fieldError("reserve_price", "reserve price %s is above the starting price %s", *update.ReservePrice, s.StartingPrice)
+		}
+	}
+	if update.EndTime != nil {
+		if update.EndTime.Before(s.StartTime) {
+			return fieldError("end_time", "end time %s is before start time %s", *update.EndTime, s.StartTime)
+		}
+		if !update.EndTime.After(at) {
+			return fieldError("end_time", "end time %s is not in the future", *update.EndTime)
+		}
+	}
+
+	if update.Name != nil {
+		s.Name = *update.Name
+	}
+	if update.ReservePrice != nil {
+		s.ReservePrice = *update.ReservePrice
+	}
+	if update.EndTime != nil {
+		s.EndTime = *update.EndTime
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	AwaitingConfirmation = "AWAITING_CONFIRMATION" // the seller has to confirm the sale
+	ExecutedSettlement   = "EXECUTED"              // the sale is final
+	NoSaleSettlement     = "NO_SALE"               // no bid won the session, e.g., the reserve price wasn't met
+)
+
+// Award is a winning bid of a session, the price its bidder pays per unit and the units they get.
+type Award struct {
+	BidId     string          `json:"bidId" db:"bid_id"`
+	UserFp    string          `json:"winnerFp" db:"winner_fp"`
+	BidAmount decimal.Decimal `json:"bidAmount" db:"bid_amount"`
+	Price     decimal.Decimal `json:"clearingPrice" db:"clearing_price"`
+	Quantity  float64         `json:"quantity" db:"quantity"`
+}
+
+// Settlement is the outcome of a session once it ended, who won and at what price.
+type Settlement struct {
+	Id           string          `json:"settlementId" db:"id"`
+	SessionId    string          `json:"sessionId" db:"session_id"`
+	AssetId      string          `json:"assetId" db:"asset_id"`
+	SellerFp     string          `json:"sellerFp" db:"seller_fp"`
+	AuctionType  string          `json:"auctionType" db:"auction_type"`
+	Status       string          `json:"status" db:"status"`
+	ReserveMet   bool            `json:"reserveMet" db:"reserve_met"`
+	ReservePrice decimal.Decimal `json:"reservePrice" db:"reserve_price"`
+	SettledAt    time.Time       `json:"settledAt" db:"settled_at"`
+	ExecutedAt   *time.Time      `json:"executedAt" db:"executed_at"`
+	Awards       []Award         `json:"awards"`
+}
+
+// NewSettlement settles the session with its awards. The sale is executed right away when the session auto executes,
+// otherwise it waits for the seller's confirmation. Without awards, or when the reserve price isn't met, nothing is sold.
+func NewSettlement(session Session, awards []Award, reserveMet bool, at time.Time) *Settlement {
+	settlement := &Settlement{
+		Id:           strconv.FormatInt(generateId(), 10),
+		SessionId:    session.Id,
+		AssetId:      session.AssetId,
+		SellerFp:     session.UserFp,
+		AuctionType:  session.ActionType,
+		ReserveMet:   reserveMet,
+		ReservePrice: session.ReservePrice,
+		SettledAt:    at,
+		Awards:       awards,
+		Status:       AwaitingConfirmation,
+	}
+	switch {
+	case !reserveMet || len(awards) == 0:
+		settlement.Status = NoSaleSettlement
+		settlement.Awards = nil
+	case session.AutoExecute:
+		settlement.Status = ExecutedSettlement
+		settlement.ExecutedAt = &at
+	}
+	return settlement
+}
+
+// Execute finalizes a sale awaiting the seller's confirmation.
+func (s *Settlement) Execute(at time.Time) error {
+	if s.Status != AwaitingConfirmation {
+		return fmt.Errorf("settlement %s is %s, only settlements awaiting confirmation can be executed", s.Id, s.Status)
+	}
+	s.Status = ExecutedSettlement
+	s.ExecutedAt = &at
+	return nil
+}
+
+// WinningBidIds are the ids of the bids awarded by the settlement.
+func (s *Settlement) WinningBidIds() []string {
+	bidIds := make([]string, 0, len(s.Awards))
+	for _, award := range s.Awards {
+		bidIds = append(bidIds, award.BidId)
+	}
+	return bidIds
+}
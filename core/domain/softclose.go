@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"time"
+	"xrf197ilz35aq2/internal/exchange"
+)
+
+// SessionExtension records a soft close moving a session's EndTime, it's pushed to websocket subscribers so their
+// countdowns stay correct.
+type SessionExtension struct {
+	SessionId       string    `json:"sessionId"`
+	AssetId         string    `json:"assetId"`
+	BidId           string    `json:"bidId"` // the bid placed within the soft close window
+	PreviousEndTime time.Time `json:"previousEndTime"`
+	EndTime         time.Time `json:"endTime"`
+	ExtendedSeconds int64     `json:"extendedSeconds"` // the session's extensions so far, this one included
+}
+
+// SupportsSoftClose reports whether sessions of the auctionType can be extended by late bids.
+func SupportsSoftClose(auctionType string) bool {
+	return auctionType == EnglishAuction || auctionType == MultiUnitAuction
+}
+
+// SoftClose extends the session when the bid, accepted at the given time, was placed within the final
+// SoftCloseSeconds of the session: the EndTime moves by ExtensionSeconds, capped so the extensions of the session
+// don't exceed MaxExtensionSeconds (no cap when zero). The bool is false when the session isn't extended.
+func (s *Session) SoftClose(bidId string, at time.Time) (SessionExtension, bool) {
+	if s.SoftCloseSeconds <= 0 || s.ExtensionSeconds <= 0 {
+		return SessionExtension{}, false
+	}
+	windowStart := s.EndTime.Add(-time.Duration(s.SoftCloseSeconds) * time.Second)
+	if at.Before(windowStart) || !at.Before(s.EndTime) {
+		return SessionExtension{}, false
+	}
+
+	extension := s.ExtensionSeconds
+	if s.MaxExtensionSeconds > 0 {
+		extension = min(extension, s.MaxExtensionSeconds-s.ExtendedSeconds)
+	}
+	if extension <= 0 {
+		return SessionExtension{}, false
+	}
+
+	previousEndTime := s.EndTime
+	s.EndTime = s.EndTime.Add(time.Duration(extension) * time.Second)
+	s.ExtendedSeconds += extension
+	return SessionExtension{
+		SessionId:       s.Id,
+		AssetId:         s.AssetId,
+		BidId:           bidId,
+		PreviousEndTime: previousEndTime,
+		EndTime:         s.EndTime,
+		ExtendedSeconds: s.ExtendedSeconds,
+	}, true
+}
+
+func validateSoftClose(sessionReq exchange.NewSessionRequest) error {
+	if sessionReq.SoftCloseSeconds == 0 && sessionReq.ExtensionSeconds == 0 && sessionReq.MaxExtensionSeconds == 0 {
+		return nil
+	}
+	if !SupportsSoftClose(sessionReq.Type) {
+		return fieldError("soft_close_seconds", "soft close is not supported by %s sessions", sessionReq.Type)
+	}
+	if sessionReq.SoftCloseSeconds <= 0 {
+		return fieldError("soft_close_seconds", "soft close window %ds is not a valid window", sessionReq.SoftCloseSeconds)
+	}
+	if sessionReq.ExtensionSeconds <= 0 {
+		return fieldError("extension_seconds", "extension %ds is not a valid extension", sessionReq.ExtensionSeconds)
+	}
+	if sessionReq.MaxExtensionSeconds < 0 {
+		return fieldError("max_extension_seconds", "maximum extension %ds is not a valid maximum extension", sessionReq.MaxExtensionSeconds)
+	}
+	return nil
+}
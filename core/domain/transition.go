@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// A session is Scheduled until its StartTime and Active until its EndTime, when it is Closed and its winners are
+// determined. A Closed session is Completed once its sale is executed. Scheduled and Active sessions can be Cancelled.
+const (
+	ActiveSession    = "Active"
+	ClosedSession    = "Closed"
+	CompletedSession = "Completed"
+	CancelledSession = "Cancelled"
+	ScheduledSession = "Scheduled"
+)
+
+// sessionTransitions are the legal status changes of a session, by the status the session is in.
+var sessionTransitions = map[string][]string{
+	ScheduledSession: {ActiveSession, CancelledSession},
+	ActiveSession:    {ClosedSession, CompletedSession, CancelledSession}, // Completed when a FixedPriceAuction sells out
+	ClosedSession:    {CompletedSession},
+	CompletedSession: {},
+	CancelledSession: {},
+}
+
+// SessionTransition records a session moving from one status to another, it's pushed to websocket subscribers.
+type SessionTransition struct {
+	SessionId string    `json:"sessionId"`
+	AssetId   string    `json:"assetId"`
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	At        time.Time `json:"at"`
+	EndTime   time.Time `json:"endTime"`
+}
+
+func IsValidSessionStatus(status string) bool {
+	_, ok := sessionTransitions[status]
+	return ok
+}
+
+// CanTransition reports whether a session in the status from can move to the status to.
+func CanTransition(from string, to string) bool {
+	for _, status := range sessionTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// IsEndedStatus reports whether no more bids are accepted by a session in the status.
+func IsEndedStatus(status string) bool {
+	return status == ClosedSession || status == CompletedSession || status == CancelledSession
+}
+
+// TransitionTo moves the session to the status at the given time. A session ending before its EndTime, e.g., a sold
+// DutchAuction or a cancelled session, has its EndTime moved to when it ended.
+func (s *Session) TransitionTo(status string, at time.Time) (SessionTransition, error) {
+	if !CanTransition(s.Status, status) {
+		return SessionTransition{}, fmt.Errorf("session %s can't move from %s to %s", s.Id, s.Status, status)
+	}
+	transition := SessionTransition{
+		SessionId: s.Id,
+		AssetId:   s.AssetId,
+		From:      s.Status,
+		To:        status,
+		At:        at,
+	}
+	s.Status = status
+	if IsEndedStatus(status) && at.Before(s.EndTime) {
+		s.EndTime = at
+	}
+	transition.EndTime = s.EndTime
+	return transition, nil
+}
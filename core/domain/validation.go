@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FieldError is a field of a request failing validation. Field is the request's field name, e.g., "end_time".
+type FieldError struct {
+	Field       string
+	Description string
+}
+
+func (e *FieldError) Error() string {
+	return e.Description
+}
+
+func fieldError(field string, format string, args ...any) error {
+	return &FieldError{Field: field, Description: fmt.Sprintf(format, args...)}
+}
+
+// ValidationError holds every field of a request failing validation.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	descriptions := make([]string, 0, len(e.Fields))
+	for _, field := range e.Fields {
+		descriptions = append(descriptions, fmt.Sprintf("%s: %s", field.Field, field.Description))
+	}
+	return fmt.Sprintf("invalid fields [%s]", strings.Join(descriptions, ", "))
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+	"xrf197ilz35aq2/core/domain"
+)
+
+// SessionLifecycle moves sessions through their statuses (see domain.TransitionTo), persisting every transition and
+// pushing it to the clients following the session (see SessionEvents).
+type SessionLifecycle interface {
+	// Transition moves the session to the status. It returns false when the session was moved by someone else first,
+	// the session is then left as it was.
+	Transition(ctx context.Context, session *domain.Session, status string, at time.Time) (bool, error)
+}
+
+type sessionLifecycle struct {
+	log         slog.Logger
+	events      SessionEvents
+	sessionRepo SessionStore
+}
+
+func (lc *sessionLifecycle) Transition(ctx context.Context, session *domain.Session, status string, at time.Time) (bool, error) {
+	previous := *session
+	transition, err := session.TransitionTo(status, at)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := lc.sessionRepo.UpdateStatus(ctx, transition)
+	if err != nil {
+		*session = previous
+		return false, fmt.Errorf("failed to persist session transition: %w", err)
+	}
+	if !updated {
+		*session = previous
+		return false, nil
+	}
+	lc.log.Info("session transitioned", "sessionId", session.Id, "from", transition.From, "to", transition.To)
+	lc.events.Transitioned(transition)
+	return true, nil
+}
+
+func NewSessionLifecycle(log slog.Logger, sessionRepo SessionStore, events SessionEvents) SessionLifecycle {
+	return &sessionLifecycle{
+		log:         log,
+		events:      events,
+		sessionRepo: sessionRepo,
+	}
+}
@@ -5,10 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"reflect"
+	"strings"
+	"unicode"
 	"xrf197ilz35aq2/core/domain"
 	"xrf197ilz35aq2/internal/exchange"
+	"xrf197ilz35aq2/validators"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
 )
 
 type SessionServ interface {
@@ -16,35 +21,91 @@ type SessionServ interface {
 }
 
 type sessionService struct {
-	validate *validator.Validate
-	log      slog.Logger
+	validate    *validator.Validate
+	log         slog.Logger
+	sessionRepo SessionStore
 }
 
+// CreateSession validates the request and persists the new session. An invalid request fails with a
+// *domain.ValidationError or *domain.FieldError naming the invalid fields.
 func (a *sessionService) CreateSession(ctx context.Context, request exchange.NewSessionRequest, userFp string) (*domain.Session, error) {
 	err := a.validateRequest(request)
 	if err != nil {
 		return nil, err
 	}
-	return nil, nil
+	session, err := domain.NewSession(request, userFp)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = a.sessionRepo.Create(ctx, session); err != nil {
+		return nil, fmt.Errorf("creating session failed with err=%w", err)
+	}
+	return session, nil
 }
 
 func (a *sessionService) validateRequest(req exchange.NewSessionRequest) error {
 	err := a.validate.Struct(req)
 	if err != nil {
 		var validationErrors validator.ValidationErrors
-		errors.As(err, &validationErrors)
-		invalidFields := make([]string, 0)
+		if !errors.As(err, &validationErrors) {
+			return fmt.Errorf("validating session request failed with err=%w", err)
+		}
+		invalidFields := make([]domain.FieldError, 0, len(validationErrors))
 		for _, validationError := range validationErrors {
-			invalidFields = append(invalidFields, validationError.Field())
+			invalidFields = append(invalidFields, domain.FieldError{
+				Field:       validationError.Field(),
+				Description: fmt.Sprintf("failed the %s validation", validationError.Tag()),
+			})
 		}
-		return errors.New(fmt.Sprintf("invalid fields [%s]", invalidFields))
+		return &domain.ValidationError{Fields: invalidFields}
 	}
 	return nil
 }
 
-func NewSessionService(validate *validator.Validate, log slog.Logger) SessionServ {
-	return &sessionService{
-		log:      log,
-		validate: validate,
+// requestFieldName names the invalid fields of a request like the API does: the field tag when set, otherwise the
+// snake_case of the json name, e.g., bidIncrementAmount is bid_increment_amount.
+func requestFieldName(field reflect.StructField) string {
+	if name := field.Tag.Get("field"); name != "" {
+		return name
+	}
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		name = field.Name
+	}
+	var snakeCase strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				snakeCase.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		snakeCase.WriteRune(r)
 	}
+	return snakeCase.String()
+}
+
+// decimalValue lets the numeric validation tags (gte, gt, ...) check decimal money fields, the float is only compared
+// to the tag's bound, the amount itself stays exact.
+func decimalValue(field reflect.Value) interface{} {
+	if amount, ok := field.Interface().(decimal.Decimal); ok {
+		return amount.InexactFloat64()
+	}
+	return nil
+}
+
+// NewSessionService creates the service with its own validator, the field names and decimal checks it registers are
+// specific to the session requests.
+func NewSessionService(log slog.Logger, sessionRepo SessionStore) (SessionServ, error) {
+	validate := validator.New()
+	if err := validate.RegisterValidation("auctionType", validators.AuctionTypeValidator); err != nil {
+		return nil, fmt.Errorf("registering auctionType validation failed with err=%w", err)
+	}
+	validate.RegisterTagNameFunc(requestFieldName)
+	validate.RegisterCustomTypeFunc(decimalValue, decimal.Decimal{})
+	return &sessionService{
+		log:         log,
+		validate:    validate,
+		sessionRepo: sessionRepo,
+	}, nil
 }
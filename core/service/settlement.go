@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+	"xrf197ilz35aq2/core/auction"
+	"xrf197ilz35aq2/core/domain"
+)
+
+var (
+	ErrSettlementNotFound = errors.New("session is not settled yet")
+	ErrNotSessionSeller   = errors.New("only the seller of the session can confirm its settlement")
+)
+
+// queuedBidsTimeout is how long after its end a session's settlement waits for its queued bids to be persisted, a bid
+// lost from the queue must not hold the settlement back forever.
+const queuedBidsTimeout = 5 * time.Minute
+
+// SessionSettlement settles ended sessions, it determines the winning bids with the rules of the session's auction type
+// (see auction.Decide), stores the settlement and marks the session's bids as won or lost.
+type SessionSettlement interface {
+	// Settle settles the ended session. It returns nil when the session isn't settled: it already was, e.g., by another
+	// instance, or some of its bids aren't persisted yet and it's settled on a later attempt.
+	Settle(ctx context.Context, session *domain.Session, at time.Time) (*domain.Settlement, error)
+	// Confirm executes the sale of a settlement awaiting the seller's confirmation and completes its session.
+	Confirm(ctx context.Context, sessionId string, sellerFp string, at time.Time) (*domain.Settlement, error)
+}
+
+type sessionSettlement struct {
+	log            slog.Logger
+	events         SessionEvents
+	lifecycle      SessionLifecycle
+	bidQueue       BidQueue
+	sessionRepo    SessionStore
+	bidRepo        BidStore
+	commitmentRepo CommitmentStore
+	settlementRepo SettlementStore
+}
+
+func (ss *sessionSettlement) Settle(ctx context.Context, session *domain.Session, at time.Time) (*domain.Settlement, error) {
+	if session.Status != domain.ClosedSession && session.Status != domain.CompletedSession {
+		return nil, fmt.Errorf("session %s is %s, only closed or completed sessions can be settled", session.Id, session.Status)
+	}
+	// the bids are only read, and marked won or lost, once persisted
+	queued, err := ss.bidQueue.QueuedBids(ctx, session.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch queued bids of session: %w", err)
+	}
+	if queued > 0 {
+		if at.Sub(session.EndTime) < queuedBidsTimeout {
+			ss.log.Debug("postponing settlement until the session's bids are persisted", "sessionId", session.Id,
+				"queued", queued)
+			return nil, nil
+		}
+		ss.log.Warn("settling session with bids still queued", "sessionId", session.Id, "queued", queued)
+	}
+
+	var bids []domain.Bid
+	var commitments []domain.BidCommitment
+	switch {
+	case domain.IsSealedAuction(session.ActionType):
+		commitments, err = ss.commitmentRepo.FindRevealedBySession(ctx, session.Id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch revealed bids of session: %w", err)
+		}
+	case session.ActionType == domain.FixedPriceAuction || session.ActionType == domain.MultiUnitAuction:
+		bids, err = ss.bidRepo.FetchAcceptedBidsBySession(ctx, session.Id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch accepted bids of session: %w", err)
+		}
+	}
+
+	awards, reserveMet := auction.Decide(*session, bids, commitments)
+	settlement := domain.NewSettlement(*session, awards, reserveMet, at)
+	created, err := ss.settlementRepo.Create(ctx, settlement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to persist settlement: %w", err)
+	}
+	if !created {
+		return nil, nil
+	}
+	ss.log.Info("session settled", "sessionId", session.Id, "settlementId", settlement.Id, "status", settlement.Status,
+		"awards", len(settlement.Awards))
+
+	marked, err := ss.bidRepo.MarkSessionBids(ctx, session.Id, settlement.WinningBidIds())
+	if err != nil {
+		ss.log.Error("failed to mark bids of settled session", "sessionId", session.Id, "err", err)
+	} else {
+		ss.log.Info("marked bids of settled session", "sessionId", session.Id, "count", marked)
+	}
+
+	if settlement.Status == domain.ExecutedSettlement {
+		ss.complete(ctx, session, at)
+	}
+	ss.events.Settled(settlement)
+	return settlement, nil
+}
+
+func (ss *sessionSettlement) Confirm(ctx context.Context, sessionId string, sellerFp string, at time.Time) (*domain.Settlement, error) {
+	settlement, err := ss.settlementRepo.FindBySessionId(ctx, sessionId)
+	if err != nil {
+		return nil, err
+	}
+	if settlement == nil {
+		return nil, ErrSettlementNotFound
+	}
+	if settlement.SellerFp != sellerFp {
+		return nil, ErrNotSessionSeller
+	}
+
+	from := settlement.Status
+	if err := settlement.Execute(at); err != nil {
+		return nil, err
+	}
+	updated, err := ss.settlementRepo.UpdateStatus(ctx, settlement.Id, from, settlement.Status, settlement.ExecutedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to persist settlement confirmation: %w", err)
+	}
+	if !updated {
+		return nil, fmt.Errorf("settlement %s was changed concurrently", settlement.Id)
+	}
+
+	session, err := ss.sessionRepo.FindById(ctx, sessionId)
+	if err != nil {
+		ss.log.Error("failed to fetch session of confirmed settlement", "sessionId", sessionId, "err", err)
+	} else {
+		ss.complete(ctx, session, at)
+	}
+	ss.events.Settled(settlement)
+	return settlement, nil
+}
+
+// complete moves the session of an executed settlement to Completed, sessions sold out on placement already are.
+func (ss *sessionSettlement) complete(ctx context.Context, session *domain.Session, at time.Time) {
+	if session.Status != domain.ClosedSession {
+		return
+	}
+	_, err := ss.lifecycle.Transition(ctx, session, domain.CompletedSession, at)
+	if err != nil {
+		ss.log.Error("failed to complete settled session", "sessionId", session.Id, "err", err)
+	}
+}
+
+func NewSessionSettlement(log slog.Logger, stores SettlementStores, lifecycle SessionLifecycle, events SessionEvents) SessionSettlement {
+	return &sessionSettlement{
+		log:            log,
+		events:         events,
+		lifecycle:      lifecycle,
+		bidQueue:       stores.BidQueue,
+		sessionRepo:    stores.Sessions,
+		bidRepo:        stores.Bids,
+		commitmentRepo: stores.Commitments,
+		settlementRepo: stores.Settlements,
+	}
+}
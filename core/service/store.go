@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"time"
+	"xrf197ilz35aq2/core/domain"
+)
+
+// The services only depend on what they read and write, the storage packages implement it (e.g., storage/postgres
+// and storage/redis) and are handed to the constructors.
+
+// SessionStore persists sessions.
+type SessionStore interface {
+	Create(ctx context.Context, session *domain.Session) (string, error)
+	FindById(ctx context.Context, sessionId string) (*domain.Session, error)
+	// UpdateStatus persists the transition, only if the session is still in its From status.
+	UpdateStatus(ctx context.Context, transition domain.SessionTransition) (bool, error)
+}
+
+// BidStore persists the bids.
+type BidStore interface {
+	FetchAcceptedBidsBySession(ctx context.Context, sessionId string) ([]domain.Bid, error)
+	MarkSessionBids(ctx context.Context, sessionId string, winningBidIds []string) (int64, error)
+}
+
+// BidQueue holds the placed bids until they are persisted.
+type BidQueue interface {
+	// QueuedBids is how many of the session's bids wait to be persisted.
+	QueuedBids(ctx context.Context, sessionId string) (int64, error)
+}
+
+// CommitmentStore persists the sealed bids.
+type CommitmentStore interface {
+	FindRevealedBySession(ctx context.Context, sessionId string) ([]domain.BidCommitment, error)
+}
+
+// SettlementStore persists the settlements.
+type SettlementStore interface {
+	// Create returns false when the session was already settled.
+	Create(ctx context.Context, settlement *domain.Settlement) (bool, error)
+	FindBySessionId(ctx context.Context, sessionId string) (*domain.Settlement, error)
+	UpdateStatus(ctx context.Context, settlementId string, from string, to string, executedAt *time.Time) (bool, error)
+}
+
+// SettlementStores are the stores SessionSettlement reads the sessions' bids from and persists the settlements to.
+type SettlementStores struct {
+	Sessions    SessionStore
+	Bids        BidStore
+	BidQueue    BidQueue
+	Commitments CommitmentStore
+	Settlements SettlementStore
+}
+
+// SessionEvents pushes what happens to the sessions to the clients following them, e.g., over websockets. The events
+// are a best effort notification: publishing must not block, nor fail the change it notifies.
+type SessionEvents interface {
+	Transitioned(transition domain.SessionTransition)
+	Settled(settlement *domain.Settlement)
+}
@@ -0,0 +1,145 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.29.3
+// source: money/v1/money.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// an exact decimal amount of money, e.g. "1234567.89". The amount is kept as its decimal text so that no precision is
+// lost on the wire, the way a float would
+type Decimal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Decimal) Reset() {
+	*x = Decimal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_money_v1_money_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Decimal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Decimal) ProtoMessage() {}
+
+func (x *Decimal) ProtoReflect() protoreflect.Message {
+	mi := &file_money_v1_money_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Decimal.ProtoReflect.Descriptor instead.
+func (*Decimal) Descriptor() ([]byte, []int) {
+	return file_money_v1_money_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Decimal) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+var File_money_v1_money_proto protoreflect.FileDescriptor
+
+var file_money_v1_money_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x6e, 0x65, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1f, 0x0a, 0x07, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61,
+	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x20, 0x5a, 0x1e, 0x78, 0x72, 0x66, 0x31, 0x39,
+	0x37, 0x69, 0x6c, 0x7a, 0x33, 0x35, 0x61, 0x71, 0x32, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f,
+	0x2f, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_money_v1_money_proto_rawDescOnce sync.Once
+	file_money_v1_money_proto_rawDescData = file_money_v1_money_proto_rawDesc
+)
+
+func file_money_v1_money_proto_rawDescGZIP() []byte {
+	file_money_v1_money_proto_rawDescOnce.Do(func() {
+		file_money_v1_money_proto_rawDescData = protoimpl.X.CompressGZIP(file_money_v1_money_proto_rawDescData)
+	})
+	return file_money_v1_money_proto_rawDescData
+}
+
+var file_money_v1_money_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_money_v1_money_proto_goTypes = []any{
+	(*Decimal)(nil), // 0: Decimal
+}
+var file_money_v1_money_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_money_v1_money_proto_init() }
+func file_money_v1_money_proto_init() {
+	if File_money_v1_money_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_money_v1_money_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Decimal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_money_v1_money_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_money_v1_money_proto_goTypes,
+		DependencyIndexes: file_money_v1_money_proto_depIdxs,
+		MessageInfos:      file_money_v1_money_proto_msgTypes,
+	}.Build()
+	File_money_v1_money_proto = out.File
+	file_money_v1_money_proto_rawDesc = nil
+	file_money_v1_money_proto_goTypes = nil
+	file_money_v1_money_proto_depIdxs = nil
+}
@@ -22,6 +22,10 @@ const (
 	BidService_CreateBid_FullMethodName      = "/BidService/CreateBid"
 	BidService_GetUserBid_FullMethodName     = "/BidService/GetUserBid"
 	BidService_StreamOpenBids_FullMethodName = "/BidService/StreamOpenBids"
+	BidService_CommitBid_FullMethodName      = "/BidService/CommitBid"
+	BidService_RevealBid_FullMethodName      = "/BidService/RevealBid"
+	BidService_SetProxyBid_FullMethodName    = "/BidService/SetProxyBid"
+	BidService_CancelBid_FullMethodName      = "/BidService/CancelBid"
 )
 
 // BidServiceClient is the client API for BidService service.
@@ -31,6 +35,10 @@ type BidServiceClient interface {
 	CreateBid(ctx context.Context, in *CreateBidRequest, opts ...grpc.CallOption) (*CreateBidResponse, error)
 	GetUserBid(ctx context.Context, in *GetUserBidRequest, opts ...grpc.CallOption) (*GetUserBidResponse, error)
 	StreamOpenBids(ctx context.Context, in *StreamOpenBidsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamOpenBidsResponse], error)
+	CommitBid(ctx context.Context, in *CommitBidRequest, opts ...grpc.CallOption) (*CommitBidResponse, error)
+	RevealBid(ctx context.Context, in *RevealBidRequest, opts ...grpc.CallOption) (*RevealBidResponse, error)
+	SetProxyBid(ctx context.Context, in *SetProxyBidRequest, opts ...grpc.CallOption) (*SetProxyBidResponse, error)
+	CancelBid(ctx context.Context, in *CancelBidRequest, opts ...grpc.CallOption) (*CancelBidResponse, error)
 }
 
 type bidServiceClient struct {
@@ -80,6 +88,46 @@ func (c *bidServiceClient) StreamOpenBids(ctx context.Context, in *StreamOpenBid
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type BidService_StreamOpenBidsClient = grpc.ServerStreamingClient[StreamOpenBidsResponse]
 
+func (c *bidServiceClient) CommitBid(ctx context.Context, in *CommitBidRequest, opts ...grpc.CallOption) (*CommitBidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommitBidResponse)
+	err := c.cc.Invoke(ctx, BidService_CommitBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) RevealBid(ctx context.Context, in *RevealBidRequest, opts ...grpc.CallOption) (*RevealBidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevealBidResponse)
+	err := c.cc.Invoke(ctx, BidService_RevealBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) SetProxyBid(ctx context.Context, in *SetProxyBidRequest, opts ...grpc.CallOption) (*SetProxyBidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProxyBidResponse)
+	err := c.cc.Invoke(ctx, BidService_SetProxyBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) CancelBid(ctx context.Context, in *CancelBidRequest, opts ...grpc.CallOption) (*CancelBidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelBidResponse)
+	err := c.cc.Invoke(ctx, BidService_CancelBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BidServiceServer is the server API for BidService service.
 // All implementations must embed UnimplementedBidServiceServer
 // for forward compatibility.
@@ -87,6 +135,10 @@ type BidServiceServer interface {
 	CreateBid(context.Context, *CreateBidRequest) (*CreateBidResponse, error)
 	GetUserBid(context.Context, *GetUserBidRequest) (*GetUserBidResponse, error)
 	StreamOpenBids(*StreamOpenBidsRequest, grpc.ServerStreamingServer[StreamOpenBidsResponse]) error
+	CommitBid(context.Context, *CommitBidRequest) (*CommitBidResponse, error)
+	RevealBid(context.Context, *RevealBidRequest) (*RevealBidResponse, error)
+	SetProxyBid(context.Context, *SetProxyBidRequest) (*SetProxyBidResponse, error)
+	CancelBid(context.Context, *CancelBidRequest) (*CancelBidResponse, error)
 	mustEmbedUnimplementedBidServiceServer()
 }
 
@@ -106,6 +158,18 @@ func (UnimplementedBidServiceServer) GetUserBid(context.Context, *GetUserBidRequ
 func (UnimplementedBidServiceServer) StreamOpenBids(*StreamOpenBidsRequest, grpc.ServerStreamingServer[StreamOpenBidsResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method StreamOpenBids not implemented")
 }
+func (UnimplementedBidServiceServer) CommitBid(context.Context, *CommitBidRequest) (*CommitBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitBid not implemented")
+}
+func (UnimplementedBidServiceServer) RevealBid(context.Context, *RevealBidRequest) (*RevealBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealBid not implemented")
+}
+func (UnimplementedBidServiceServer) SetProxyBid(context.Context, *SetProxyBidRequest) (*SetProxyBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProxyBid not implemented")
+}
+func (UnimplementedBidServiceServer) CancelBid(context.Context, *CancelBidRequest) (*CancelBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBid not implemented")
+}
 func (UnimplementedBidServiceServer) mustEmbedUnimplementedBidServiceServer() {}
 func (UnimplementedBidServiceServer) testEmbeddedByValue()                    {}
 
@@ -174,6 +238,78 @@ func _BidService_StreamOpenBids_Handler(srv interface{}, stream grpc.ServerStrea
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type BidService_StreamOpenBidsServer = grpc.ServerStreamingServer[StreamOpenBidsResponse]
 
+func _BidService_CommitBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).CommitBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_CommitBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).CommitBid(ctx, req.(*CommitBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_RevealBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevealBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).RevealBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_RevealBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).RevealBid(ctx, req.(*RevealBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_SetProxyBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProxyBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).SetProxyBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_SetProxyBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).SetProxyBid(ctx, req.(*SetProxyBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_CancelBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).CancelBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_CancelBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).CancelBid(ctx, req.(*CancelBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BidService_ServiceDesc is the grpc.ServiceDesc for BidService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -189,6 +325,22 @@ var BidService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserBid",
 			Handler:    _BidService_GetUserBid_Handler,
 		},
+		{
+			MethodName: "CommitBid",
+			Handler:    _BidService_CommitBid_Handler,
+		},
+		{
+			MethodName: "RevealBid",
+			Handler:    _BidService_RevealBid_Handler,
+		},
+		{
+			MethodName: "SetProxyBid",
+			Handler:    _BidService_SetProxyBid_Handler,
+		},
+		{
+			MethodName: "CancelBid",
+			Handler:    _BidService_CancelBid_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -45,11 +45,33 @@ type RedisConfig struct {
 	WriteTimeout int    `yaml:"writeTimeout"`
 }
 
+// AuthConfig verifies the callers' credentials. TokenSecret is shared with the account service issuing the bearer tokens
+// callers authenticate with (see identity.TokenSigner), set it with the XRF_Q2_AUTH_TOKENSECRET environment variable
+// outside DEV.
+type AuthConfig struct {
+	TokenSecret string `yaml:"tokenSecret"`
+}
+
+// WebSocketConfig admits websocket connections. TokenSecret signs the tokens browsers open websockets with, it must differ
+// from AuthConfig.TokenSecret, set it with the XRF_Q2_WEBSOCKET_TOKENSECRET environment variable outside DEV. Broadcast is memory for a single instance, redis
+// when several instances serve websockets. SlowConsumerPolicy is what happens to a client reading its messages too slowly:
+// disconnect (the default), drop_oldest or coalesce.
+type WebSocketConfig struct {
+	AllowedOrigins []string `yaml:"allowedOrigins"`
+	TokenSecret    string   `yaml:"tokenSecret"`
+	TokenTTL       int      `yaml:"tokenTTL"` // seconds
+	Broadcast      string   `yaml:"broadcast"`
+	// SlowConsumerPolicy is disconnect, drop_oldest or coalesce
+	SlowConsumerPolicy string `yaml:"slowConsumerPolicy"`
+}
+
 type Config struct {
-	Log         LogConfig      `yml:"log"`
-	Redis       RedisConfig    `yml:"redis"`
-	Postgres    PostgresConfig `yml:"postgres"`
-	TimescaleDB PostgresConfig `yml:"timescaledb"`
+	Log         LogConfig       `yml:"log"`
+	Redis       RedisConfig     `yml:"redis"`
+	Postgres    PostgresConfig  `yml:"postgres"`
+	TimescaleDB PostgresConfig  `yml:"timescaledb"`
+	Auth        AuthConfig      `yml:"auth"`
+	WebSocket   WebSocketConfig `yml:"websocket"`
 }
 
 var (
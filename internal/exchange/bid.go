@@ -1,10 +1,14 @@
 package exchange
 
-import "time"
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
 
 type BidRequest struct {
-	Amount    float64   `json:"amount"`
-	UserFp    string    `json:"placedBy"`
-	AssetId   string    `json:"assetId"`
-	LastUntil time.Time `json:"lastUntil"`
+	Amount    decimal.Decimal `json:"amount"`
+	UserFp    string          `json:"placedBy"`
+	AssetId   string          `json:"assetId"`
+	LastUntil time.Time       `json:"lastUntil"`
 }
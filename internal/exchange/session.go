@@ -1,14 +1,28 @@
 package exchange
 
-import "time"
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
 
 type NewSessionRequest struct {
-	AssetId            string    `json:"assetId" validate:"required"`
-	Name               string    `json:"name"`
-	EndTime            time.Time `json:"endTime"  validate:"required"`
-	StartTime          time.Time `json:"startTime"  validate:"required"`
-	ReservePrice       float64   `json:"reservePrice"`
-	AutoExecute        bool      `json:"autoExecute"`
-	BidIncrementAmount float64   `json:"bidIncrementAmount" validate:"required,numeric,gt=0"`
-	Type               string    `json:"type"  validate:"auctionType"`
+	AssetId             string          `json:"assetId" validate:"required"`
+	Name                string          `json:"name"`
+	EndTime             time.Time       `json:"endTime"  validate:"required"`
+	StartTime           time.Time       `json:"startTime"  validate:"required"`
+	ReservePrice        decimal.Decimal `json:"reservePrice"`
+	AutoExecute         bool            `json:"autoExecute"`
+	BidIncrementAmount  decimal.Decimal `json:"bidIncrementAmount" validate:"gte=0"`
+	Type                string          `json:"type"  validate:"auctionType" field:"auction_type"`
+	StartingPrice       decimal.Decimal `json:"startingPrice"`
+	PriceStep           decimal.Decimal `json:"priceStep"`
+	PriceStepSeconds    int64           `json:"priceStepSeconds"`
+	RevealSeconds       int64           `json:"revealSeconds"`
+	UnitPrice           decimal.Decimal `json:"unitPrice"`
+	AvailableQuantity   float64         `json:"availableQuantity"`
+	PricingRule         string          `json:"pricingRule"`
+	SoftCloseSeconds    int64           `json:"softCloseSeconds"`
+	ExtensionSeconds    int64           `json:"extensionSeconds"`
+	MaxExtensionSeconds int64           `json:"maxExtensionSeconds"`
 }
@@ -3,11 +3,13 @@ package worker
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
 	"xrf197ilz35aq2/core/domain"
 	"xrf197ilz35aq2/storage/postgres"
+	cache "xrf197ilz35aq2/storage/redis"
 	"xrf197ilz35aq2/storage/timescale/queries"
 
 	"github.com/redis/go-redis/v9"
@@ -18,9 +20,15 @@ type JobsConfig struct {
 	sleep   time.Duration
 }
 
+// NewJobsConfig configures how long a worker waits for a job (timeout) and how long it sleeps between jobs.
+func NewJobsConfig(timeout time.Duration, sleep time.Duration) JobsConfig {
+	return JobsConfig{timeout: timeout, sleep: sleep}
+}
+
 type BidWorker struct {
 	log          slog.Logger
 	client       *redis.Client
+	bidCache     cache.BidCache
 	timeout      time.Duration
 	sleep        time.Duration
 	tsBidQuerier queries.BidTSQuerier
@@ -31,8 +39,15 @@ type BidWorker struct {
 // Start this in a `main` function, likely as a goroutine.
 func (worker *BidWorker) ProcessCachedBidsFromQueue(ctx context.Context, queue string) error {
 	for {
+		if ctx.Err() != nil {
+			worker.log.Info("** bid worker shutting down **")
+			return ctx.Err()
+		}
 		// 1. Fetch bids from a cache
 		result, err := worker.client.BLPop(ctx, worker.timeout, queue).Result()
+		if errors.Is(err, redis.Nil) {
+			continue // no bid queued within the timeout
+		}
 		if err != nil {
 			worker.log.Warn("Error fetching bid from queue", "err", err)
 			time.Sleep(worker.sleep) // Simple backoff
@@ -46,7 +61,8 @@ func (worker *BidWorker) ProcessCachedBidsFromQueue(ctx context.Context, queue s
 
 		// 2. Process cached string bids and unmarshal them into Bid struct
 		bids := make([]domain.Bid, 0)
-		for _, cachedBid := range result {
+		// BLPop returns the key of the queue followed by the popped bid
+		for _, cachedBid := range result[1:] {
 			var bid domain.Bid
 			if err := json.Unmarshal([]byte(cachedBid), &bid); err != nil {
 				worker.log.Error("Error unmarshalling bid from queue: %v", "err", err)
@@ -60,18 +76,28 @@ func (worker *BidWorker) ProcessCachedBidsFromQueue(ctx context.Context, queue s
 			time.Sleep(worker.sleep)
 			continue
 		}
+		worker.markCancelledBids(ctx, bids)
 
 		worker.log.Info(fmt.Sprintf("Successfully fetched %d bids from queue", len(bids)))
 		// 3. Store/Save bids permanently in the DB
 		count, err := worker.bidRepo.CreateBidsCopyFrom(ctx, bids)
 		if err != nil {
 			worker.log.Error("Error creating bids", "err", err)
+			// the bids go back to the queue to be retried, their sessions' settlement keeps waiting for them
+			if err := worker.bidCache.RequeueBids(ctx, result[1:len(bids)+1]); err != nil {
+				worker.log.Error("Error requeuing bids", "count", len(bids), "err", err)
+			}
 			time.Sleep(worker.sleep)
 			continue
 		}
+		// the bids are persisted, their sessions' settlement no longer waits for them
+		if err := worker.bidCache.DequeueBids(ctx, bids); err != nil {
+			worker.log.Error("Error dequeuing bids", "err", err)
+		}
 
 		// 4. Log successfully stored bids
 		worker.logSavedBids("postgres", err, count, int64(len(bids)))
+		worker.cancelRetractedBids(ctx, bids)
 
 		go saveBidsToTSDB(ctx, worker, bids)
 
@@ -81,6 +107,48 @@ func (worker *BidWorker) ProcessCachedBidsFromQueue(ctx context.Context, queue s
 	}
 }
 
+// markCancelledBids marks the bids retracted while they were queued as cancelled.
+func (worker *BidWorker) markCancelledBids(ctx context.Context, bids []domain.Bid) {
+	bidIds := make([]string, 0, len(bids))
+	for _, bid := range bids {
+		bidIds = append(bidIds, bid.Id)
+	}
+	cancelled, err := worker.bidCache.CancelledBids(ctx, bidIds)
+	if err != nil {
+		worker.log.Error("Error fetching cancelled bids", "err", err)
+		return
+	}
+	for i := range bids {
+		if cancelled[bids[i].Id] {
+			bids[i].Cancel()
+		}
+	}
+}
+
+// cancelRetractedBids cancels the persisted bids retracted while the worker was persisting them: the retraction found no
+// bid to cancel yet, and the bids were checked before it was recorded.
+func (worker *BidWorker) cancelRetractedBids(ctx context.Context, bids []domain.Bid) {
+	bidIds := make([]string, 0, len(bids))
+	for _, bid := range bids {
+		if bid.Status != domain.CancelledBid {
+			bidIds = append(bidIds, bid.Id)
+		}
+	}
+	cancelled, err := worker.bidCache.CancelledBids(ctx, bidIds)
+	if err != nil {
+		worker.log.Error("Error fetching cancelled bids", "err", err)
+		return
+	}
+	for _, bidId := range bidIds {
+		if !cancelled[bidId] {
+			continue
+		}
+		if _, err := worker.bidRepo.CancelBid(ctx, bidId); err != nil {
+			worker.log.Error("Error cancelling retracted bid", "bidId", bidId, "err", err)
+		}
+	}
+}
+
 func saveBidsToTSDB(ctx context.Context, worker *BidWorker, bids []domain.Bid) {
 	// 4. Save bids to the timescale db as well
 	tsRespCnt, err := worker.tsBidQuerier.BatchSave(ctx, bids)
@@ -103,10 +171,12 @@ func (worker *BidWorker) logSavedBids(dbTye string, err error, count int64, expe
 	}
 }
 
-func NewBidWorker(log slog.Logger, client *redis.Client, config JobsConfig, bidRepo postgres.BidRepository, tsQuerier queries.BidTSQuerier) *BidWorker {
+func NewBidWorker(log slog.Logger, client *redis.Client, bidCache cache.BidCache, config JobsConfig, bidRepo postgres.BidRepository,
+	tsQuerier queries.BidTSQuerier) *BidWorker {
 	return &BidWorker{
 		log:          log,
 		client:       client,
+		bidCache:     bidCache,
 		bidRepo:      bidRepo,
 		tsBidQuerier: tsQuerier,
 		sleep:        config.sleep,
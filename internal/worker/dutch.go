@@ -0,0 +1,79 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+	"xrf197ilz35aq2/core/auction"
+	"xrf197ilz35aq2/core/domain"
+	"xrf197ilz35aq2/server/socket"
+	"xrf197ilz35aq2/storage/postgres"
+
+	"github.com/shopspring/decimal"
+)
+
+// DutchAuctionClock pushes the descending price of every running DutchAuction session to the websocket subscribers.
+// The price itself is derived from the session and the time (see auction.DutchAuction), the clock only checks running
+// sessions every tick and broadcasts the sessions whose price dropped since the last broadcast.
+type DutchAuctionClock struct {
+	log         slog.Logger
+	hub         *socket.Hub
+	tick        time.Duration
+	sessionRepo postgres.SessionRepository
+	prices      map[string]decimal.Decimal // last broadcast price by session id
+}
+
+func (clock *DutchAuctionClock) Run(ctx context.Context) error {
+	ticker := time.NewTicker(clock.tick)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			clock.log.Info("** dutch auction clock shutting down **")
+			return ctx.Err()
+		case now := <-ticker.C:
+			clock.broadcastPrices(ctx, now)
+		}
+	}
+}
+
+func (clock *DutchAuctionClock) broadcastPrices(ctx context.Context, now time.Time) {
+	sessions, err := clock.sessionRepo.FindRunningByAuctionType(ctx, domain.DutchAuction, now)
+	if err != nil {
+		clock.log.Error("failed to fetch running dutch auction sessions", "err", err)
+		return
+	}
+
+	dutchAuction := auction.DutchAuction{}
+	running := make(map[string]decimal.Decimal, len(sessions))
+	for _, session := range sessions {
+		if session.CurrentHighestBid.IsPositive() {
+			continue // already sold, the session is closing
+		}
+		tick := dutchAuction.Tick(session, now)
+		running[session.Id] = tick.Price
+		if lastPrice, ok := clock.prices[session.Id]; ok && lastPrice.Equal(tick.Price) {
+			continue
+		}
+
+		messageBytes, err := json.Marshal(tick)
+		if err != nil {
+			clock.log.Error("failed to marshal price tick for websocket listeners", "sessionId", session.Id, "err", err)
+			continue
+		}
+		clock.hub.Publish(socket.NewMessage(socket.PriceTickEvent, tick.AssetId, tick.SessionId, messageBytes))
+	}
+	// sessions that stopped running are dropped
+	clock.prices = running
+}
+
+func NewDutchAuctionClock(log slog.Logger, hub *socket.Hub, sessionRepo postgres.SessionRepository, tick time.Duration) *DutchAuctionClock {
+	return &DutchAuctionClock{
+		log:         log,
+		hub:         hub,
+		tick:        tick,
+		sessionRepo: sessionRepo,
+		prices:      make(map[string]decimal.Decimal),
+	}
+}
@@ -0,0 +1,91 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+	"xrf197ilz35aq2/core/domain"
+	"xrf197ilz35aq2/core/service"
+	"xrf197ilz35aq2/storage/postgres"
+)
+
+// SessionScheduler opens scheduled sessions at their start time, closes active sessions at their end time and settles
+// ended sessions, sealed auction sessions once their reveal phase is over.
+// Transitions are compare-and-set on the session's status and a session is settled once, so several instances can run
+// the scheduler.
+type SessionScheduler struct {
+	log         slog.Logger
+	tick        time.Duration
+	lifecycle   service.SessionLifecycle
+	settlement  service.SessionSettlement
+	sessionRepo postgres.SessionRepository
+}
+
+func (scheduler *SessionScheduler) Run(ctx context.Context) error {
+	ticker := time.NewTicker(scheduler.tick)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			scheduler.log.Info("** session scheduler shutting down **")
+			return ctx.Err()
+		case now := <-ticker.C:
+			scheduler.openDueSessions(ctx, now)
+			scheduler.closeDueSessions(ctx, now)
+			scheduler.settleDueSessions(ctx, now)
+		}
+	}
+}
+
+func (scheduler *SessionScheduler) openDueSessions(ctx context.Context, now time.Time) {
+	sessions, err := scheduler.sessionRepo.FindDueToOpen(ctx, now)
+	if err != nil {
+		scheduler.log.Error("failed to fetch sessions due to open", "err", err)
+		return
+	}
+	for i := range sessions {
+		scheduler.transition(ctx, &sessions[i], domain.ActiveSession, now)
+	}
+}
+
+func (scheduler *SessionScheduler) closeDueSessions(ctx context.Context, now time.Time) {
+	sessions, err := scheduler.sessionRepo.FindDueToClose(ctx, now)
+	if err != nil {
+		scheduler.log.Error("failed to fetch sessions due to close", "err", err)
+		return
+	}
+	for i := range sessions {
+		scheduler.transition(ctx, &sessions[i], domain.ClosedSession, now)
+	}
+}
+
+func (scheduler *SessionScheduler) settleDueSessions(ctx context.Context, now time.Time) {
+	sessions, err := scheduler.sessionRepo.FindDueToSettle(ctx, now)
+	if err != nil {
+		scheduler.log.Error("failed to fetch sessions due to settle", "err", err)
+		return
+	}
+	for i := range sessions {
+		if _, err := scheduler.settlement.Settle(ctx, &sessions[i], now); err != nil {
+			scheduler.log.Error("failed to settle session", "sessionId", sessions[i].Id, "err", err)
+		}
+	}
+}
+
+func (scheduler *SessionScheduler) transition(ctx context.Context, session *domain.Session, status string, now time.Time) {
+	_, err := scheduler.lifecycle.Transition(ctx, session, status, now)
+	if err != nil {
+		scheduler.log.Error("failed to transition session", "sessionId", session.Id, "status", status, "err", err)
+	}
+}
+
+func NewSessionScheduler(log slog.Logger, lifecycle service.SessionLifecycle, settlement service.SessionSettlement,
+	sessionRepo postgres.SessionRepository, tick time.Duration) *SessionScheduler {
+	return &SessionScheduler{
+		log:         log,
+		tick:        tick,
+		lifecycle:   lifecycle,
+		settlement:  settlement,
+		sessionRepo: sessionRepo,
+	}
+}
@@ -8,7 +8,8 @@ PROTO_DIR="proto"
 OUTPUT_DIR="." # Or specify a staging directory if you prefer
 
 # Find all .proto files and execute protoc for each
-find "${PROTO_DIR}" -name "*.proto" -print0 | while IFS= read -r -d $'\0' proto_file; do
+# google/api protos are the google.api.http annotations, their Go code comes from google.golang.org/genproto
+find "${PROTO_DIR}" -name "*.proto" -not -path "${PROTO_DIR}/google/*" -print0 | while IFS= read -r -d $'\0' proto_file; do
   echo "Compiling ${proto_file}..."
   protoc \
     --proto_path="${PROTO_DIR}" \
@@ -16,6 +17,8 @@ find "${PROTO_DIR}" -name "*.proto" -print0 | while IFS= read -r -d $'\0' proto_
     --go_opt=module="${GO_MODULE_PATH}" \
     --go-grpc_out="${OUTPUT_DIR}" \
     --go-grpc_opt=module="${GO_MODULE_PATH}" \
+    --grpc-gateway_out="${OUTPUT_DIR}" \
+    --grpc-gateway_opt=module="${GO_MODULE_PATH}" \
     "${proto_file}"
 done
 
@@ -28,3 +31,5 @@ echo "Protobuf compilation finished."
     # to this module path, based on the go_package option in your .proto file
 # --go-grpc_out= -> Specifies the output directory for the generated Go gRPC service files (*_grpc.pb.go).
 # --go-grpc_opt=module=xrf197ilz35aq2 -> Tells protoc-gen-go-grpc the Go module path for gRPC file generation
+# --grpc-gateway_out= -> Specifies the output directory for the generated JSON gateway files (*.pb.gw.go), needs
+    # protoc-gen-grpc-gateway (go install github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-grpc-gateway@latest)
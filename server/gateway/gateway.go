@@ -0,0 +1,77 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/textproto"
+	sessionV1 "xrf197ilz35aq2/gen/go/service/session/v1"
+	bidV1 "xrf197ilz35aq2/gen/go/service/v1"
+	"xrf197ilz35aq2/server/grpc/interceptor"
+	"xrf197ilz35aq2/server/grpc/services"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Prefix is the path every gateway route is served under.
+const Prefix = "/v1/"
+
+// NewGateway serves BidService and SessionService as a JSON HTTP API, the routes are the google.api.http options of
+// the protos. Requests are forwarded to the gRPC server listening on grpcAddress, so they run the same interceptors
+// (auth, request ids, logging, recovery) and service implementations as gRPC calls. gRPC status codes are mapped to
+// HTTP status codes, e.g., codes.NotFound to 404, by runtime.HTTPStatusFromCode. The connection to the gRPC server is
+// closed once the context is canceled.
+func NewGateway(ctx context.Context, log slog.Logger, grpcAddress string) (http.Handler, error) {
+	conn, err := grpc.NewClient(grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("connecting gateway to gRPC server failed with err=%w", err)
+	}
+	go func() {
+		<-ctx.Done()
+		if err := conn.Close(); err != nil {
+			log.Error("failed to close gateway gRPC connection", "err", err)
+		}
+	}()
+
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeader),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeader),
+		runtime.WithRoutingErrorHandler(func(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler,
+			w http.ResponseWriter, r *http.Request, httpStatus int) {
+			log.Info("gateway route not found", "method", r.Method, "path", r.URL.Path, "status", httpStatus)
+			runtime.DefaultRoutingErrorHandler(ctx, mux, marshaler, w, r, httpStatus)
+		}),
+	)
+
+	if err = bidV1.RegisterBidServiceHandler(ctx, mux, conn); err != nil {
+		return nil, fmt.Errorf("registering bid service gateway failed with err=%w", err)
+	}
+	if err = sessionV1.RegisterSessionServiceHandler(ctx, mux, conn); err != nil {
+		return nil, fmt.Errorf("registering session service gateway failed with err=%w", err)
+	}
+	return mux, nil
+}
+
+// incomingHeader forwards the request id and idempotency key headers to the gRPC server as they are, like gRPC clients
+// send them. The conventional HTTP Idempotency-Key header is accepted too. The Authorization header is always forwarded
+// as the authorization metadata by the runtime.
+func incomingHeader(key string) (string, bool) {
+	switch textproto.CanonicalMIMEHeaderKey(key) {
+	case "Idempotency-Key", "X-Idempotency-Key":
+		return services.IdempotencyHeader, true
+	case textproto.CanonicalMIMEHeaderKey(interceptor.RequestIdHeader):
+		return interceptor.RequestIdHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeader sends the request id back as is, other gRPC response headers get the Grpc-Metadata- prefix.
+func outgoingHeader(key string) (string, bool) {
+	if key == interceptor.RequestIdHeader {
+		return textproto.CanonicalMIMEHeaderKey(key), true
+	}
+	return fmt.Sprintf("%s%s", runtime.MetadataHeaderPrefix, key), true
+}
@@ -0,0 +1,94 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+	"time"
+	"xrf197ilz35aq2/server/identity"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// publicMethodPrefixes are the methods served without a caller identity, e.g., server reflection and health checks.
+var publicMethodPrefixes = []string{
+	"/grpc.reflection.",
+	"/grpc.health.v1.",
+}
+
+// UnaryAuth verifies the caller's credential, an identity.AuthorizationHeader bearer token, and puts the user
+// fingerprint it carries into the context of every non-public RPC.
+func UnaryAuth(verifier identity.TokenVerifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authenticate(ctx, verifier, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuth is UnaryAuth for streaming RPCs.
+func StreamAuth(verifier identity.TokenVerifier) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), verifier, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func authenticate(ctx context.Context, verifier identity.TokenVerifier, fullMethod string) (context.Context, error) {
+	userFp, err := userFpFromMetadata(ctx, verifier)
+	if err != nil {
+		if isPublicMethod(fullMethod) {
+			return ctx, nil
+		}
+		return nil, err
+	}
+	if caller, ok := ctx.Value(callerKey).(*rpcCaller); ok {
+		caller.userFp = userFp // for the logging interceptor
+	}
+	return withUserFp(ctx, userFp), nil
+}
+
+// userFpFromMetadata verifies the caller's bearer token from the incoming gRPC metadata and returns its user fingerprint.
+func userFpFromMetadata(ctx context.Context, verifier identity.TokenVerifier) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", status.Errorf(codes.Unauthenticated, "missing metadata")
+	}
+
+	// Header keys are conventionally lowercase in metadata.MD
+	token, err := identity.BearerToken(md.Get(identity.AuthorizationHeader))
+	if err != nil {
+		return "", status.Errorf(codes.Unauthenticated, "%s", err)
+	}
+	userFp, err := verifier.Verify(token, time.Now())
+	if err != nil {
+		return "", status.Errorf(codes.Unauthenticated, "%s", err)
+	}
+	return userFp, nil
+}
+
+func isPublicMethod(fullMethod string) bool {
+	for _, prefix := range publicMethodPrefixes {
+		if strings.HasPrefix(fullMethod, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// contextStream serves a stream with the context enriched by an interceptor.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,40 @@
+package interceptor
+
+import "context"
+
+type contextKey int
+
+const (
+	userFpKey contextKey = iota
+	requestIdKey
+	callerKey
+)
+
+// rpcCaller is filled in with the authenticated caller by the auth interceptor, which runs after the logging one.
+type rpcCaller struct {
+	userFp string
+}
+
+// UserFp returns the fingerprint of the authenticated caller, false when the method doesn't require one and none was sent.
+func UserFp(ctx context.Context) (string, bool) {
+	userFp, ok := ctx.Value(userFpKey).(string)
+	return userFp, ok && userFp != ""
+}
+
+// RequestId returns the id of the request being served, assigned by the client or generated on arrival.
+func RequestId(ctx context.Context) string {
+	requestId, _ := ctx.Value(requestIdKey).(string)
+	return requestId
+}
+
+func withUserFp(ctx context.Context, userFp string) context.Context {
+	return context.WithValue(ctx, userFpKey, userFp)
+}
+
+func withCaller(ctx context.Context, caller *rpcCaller) context.Context {
+	return context.WithValue(ctx, callerKey, caller)
+}
+
+func withRequestId(ctx context.Context, requestId string) context.Context {
+	return context.WithValue(ctx, requestIdKey, requestId)
+}
@@ -0,0 +1,112 @@
+package interceptor
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RequestIdHeader carries the id of a request, the client's is kept, otherwise one is generated. It's sent back in
+// the response headers.
+const RequestIdHeader = "x-request-id"
+
+const maxRequestIdLength = 64
+
+// UnaryRequestId assigns, or propagates, the request id of every RPC.
+func UnaryRequestId() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		requestId := requestIdFromMetadata(ctx)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIdHeader, requestId))
+		return handler(withRequestId(ctx, requestId), req)
+	}
+}
+
+// StreamRequestId is UnaryRequestId for streaming RPCs.
+func StreamRequestId() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		requestId := requestIdFromMetadata(ss.Context())
+		_ = ss.SetHeader(metadata.Pairs(RequestIdHeader, requestId))
+		return handler(srv, &contextStream{ServerStream: ss, ctx: withRequestId(ss.Context(), requestId)})
+	}
+}
+
+func requestIdFromMetadata(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(RequestIdHeader); len(values) > 0 && values[0] != "" && len(values[0]) <= maxRequestIdLength {
+			return values[0]
+		}
+	}
+	return uuid.NewString()
+}
+
+// UnaryLogging logs the method, status and latency of every RPC.
+func UnaryLogging(log slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		caller := &rpcCaller{}
+		resp, err := handler(withCaller(ctx, caller), req)
+		logRPC(ctx, log, info.FullMethod, start, caller, err)
+		return resp, err
+	}
+}
+
+// StreamLogging is UnaryLogging for streaming RPCs, the latency is how long the stream stayed open.
+func StreamLogging(log slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		caller := &rpcCaller{}
+		err := handler(srv, &contextStream{ServerStream: ss, ctx: withCaller(ss.Context(), caller)})
+		logRPC(ss.Context(), log, info.FullMethod, start, caller, err)
+		return err
+	}
+}
+
+// logRPC logs the call, userFp is the caller authenticated by the auth interceptor, empty when it wasn't.
+func logRPC(ctx context.Context, log slog.Logger, method string, start time.Time, caller *rpcCaller, err error) {
+	code := status.Code(err)
+	level := slog.LevelInfo
+	switch code {
+	case codes.OK, codes.Canceled, codes.NotFound, codes.InvalidArgument, codes.AlreadyExists, codes.FailedPrecondition,
+		codes.Unauthenticated, codes.PermissionDenied, codes.OutOfRange:
+	default:
+		level = slog.LevelError
+	}
+	log.Log(ctx, level, "grpc request", "method", method, "code", code.String(), "latency", time.Since(start),
+		"requestId", RequestId(ctx), "userFp", caller.userFp)
+}
+
+// UnaryRecovery turns a panic of an RPC handler into a codes.Internal error instead of crashing the process.
+func UnaryRecovery(log slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(ctx, log, info.FullMethod, r)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// StreamRecovery is UnaryRecovery for streaming RPCs.
+func StreamRecovery(log slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(ss.Context(), log, info.FullMethod, r)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+func recovered(ctx context.Context, log slog.Logger, method string, r any) error {
+	log.Error("recovered from panic", "method", method, "requestId", RequestId(ctx), "panic", r, "stack", string(debug.Stack()))
+	return status.Errorf(codes.Internal, "internal error")
+}
@@ -2,25 +2,49 @@ package grpc
 
 import (
 	"log/slog"
+	"xrf197ilz35aq2/core/service"
 	sessionV1 "xrf197ilz35aq2/gen/go/service/session/v1"
 	bidV1 "xrf197ilz35aq2/gen/go/service/v1"
+	"xrf197ilz35aq2/server/grpc/interceptor"
 	"xrf197ilz35aq2/server/grpc/services"
+	"xrf197ilz35aq2/server/identity"
 	"xrf197ilz35aq2/server/socket"
 	"xrf197ilz35aq2/storage/postgres"
 	"xrf197ilz35aq2/storage/redis"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
-func NewGRPCSrv(log slog.Logger, cacheClient redis.CacheClients, repos postgres.Repositories, hub *socket.Hub) (*grpc.Server, error) {
+func NewGRPCSrv(log slog.Logger, cacheClient redis.CacheClients, repos postgres.Repositories, hub *socket.Hub, sessions service.SessionServ,
+	lifecycle service.SessionLifecycle, settlement service.SessionSettlement, healthSrv *health.Server,
+	verifier identity.TokenVerifier) (*grpc.Server, error) {
 	// 1. Create a gRPC server object
-	// Pass in server options here, like interceptors, TLS credentials, etc.
-	grpcServer := grpc.NewServer()
+	// Interceptors run in order: the request id is assigned first so every log line of the request carries it, then the
+	// request is logged, panics are recovered into codes.Internal, and lastly the caller's credential is verified (the
+	// logged user is the one verified).
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			interceptor.UnaryRequestId(),
+			interceptor.UnaryLogging(log),
+			interceptor.UnaryRecovery(log),
+			interceptor.UnaryAuth(verifier),
+		),
+		grpc.ChainStreamInterceptor(
+			interceptor.StreamRequestId(),
+			interceptor.StreamLogging(log),
+			interceptor.StreamRecovery(log),
+			interceptor.StreamAuth(verifier),
+		),
+	)
 
 	// 2. Register service implementations with the gRPC server.
-	sessionV1.RegisterSessionServiceServer(grpcServer, services.NewSessionServiceServer(log, repos.SessionRepository))
-	bidV1.RegisterBidServiceServer(grpcServer, services.NewBidService(log, cacheClient.BidClient, repos.BidRepository, hub))
+	sessionV1.RegisterSessionServiceServer(grpcServer, services.NewSessionServiceServer(log, repos, sessions, lifecycle, settlement))
+	bidV1.RegisterBidServiceServer(grpcServer, services.NewBidService(log, cacheClient, repos, hub, lifecycle))
+	// the serving statuses are kept up to date by the health.Checker probing the services' dependencies
+	healthpb.RegisterHealthServer(grpcServer, healthSrv)
 
 	// 3. Optional: Register gRPC server reflection.
 	// This allows gRPC clients (like grpcurl or a GUI client) to query what services and methods are available on
@@ -3,141 +3,363 @@ package services
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log/slog"
 	"time"
+	"xrf197ilz35aq2/core/auction"
+	"xrf197ilz35aq2/core/domain"
+	"xrf197ilz35aq2/core/service"
 	v1 "xrf197ilz35aq2/gen/go/service/v1"
-	"xrf197ilz35aq2/internal/exchange"
 	"xrf197ilz35aq2/server/socket"
 	"xrf197ilz35aq2/storage/postgres"
 	"xrf197ilz35aq2/storage/redis"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	// maxPlaceBidAttempts bounds how many times a bid is re-evaluated when concurrent bids keep raising the session's highest bid.
+	maxPlaceBidAttempts = 5
+	// defaultBidPageSize is the page size of bid listings when the request doesn't set a limit.
+	defaultBidPageSize = 50
+)
+
 type bidService struct {
-	hub            *socket.Hub
-	Log            slog.Logger
-	BidCacheClient redis.BidCache
-	BidRepo        postgres.BidRepository
-	SessionRepo    postgres.SessionRepository
+	hub              *socket.Hub
+	lifecycle        service.SessionLifecycle
+	Log              slog.Logger
+	BidCacheClient   redis.BidCache
+	IdempotencyCache redis.IdempotencyCache
+	BidRepo          postgres.BidRepository
+	SessionRepo      postgres.SessionRepository
+	CommitmentRepo   postgres.CommitmentRepository
+	ProxyBidRepo     postgres.ProxyBidRepository
 
 	v1.UnimplementedBidServiceServer
 }
 
 func (srv *bidService) CreateBid(ctx context.Context, request *v1.CreateBidRequest) (*v1.CreateBidResponse, error) {
-	// Extract metadata from the incoming context
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		srv.Log.Error("Error: Missing metadata in context for create bid")
-		// No metadata was sent at all, which is unusual for gRPC calls since gRPC often adds its own internal metadata.
-		// Decide how to handle this; often if specific metadata is required.
-		return nil, status.Errorf(codes.InvalidArgument, "missing metadata")
+	userFp, err := userFpFromContext(ctx)
+	if err != nil {
+		srv.Log.Error("Error: missing user fingerprint in context for create bid", "err", err)
+		return nil, err
 	}
 
-	// 2. Check for the required header "x-auth-token"
-	// Header keys are conventionally lowercase in metadata.MD
-	userFPValues := md.Get("x-rfz-user")
-	if len(userFPValues) == 0 {
-		srv.Log.Error("Error: Empty user fingerprint header in context for create bid")
-		return nil, status.Errorf(codes.Unauthenticated, "missing user header")
+	idempotencyKey, err := idempotencyKeyFromRequest(ctx, request)
+	if err != nil {
+		return nil, err
 	}
-
-	// 3. Use the header value (taking the first one if multiple is sent)
-	userFp := userFPValues[0]
-	if userFp == "" { // Or perform more specific validation
-		return nil, status.Errorf(codes.Unauthenticated, "user fingerprint is empty in header")
+	if idempotencyKey != "" {
+		return srv.createBidOnce(ctx, userFp, idempotencyKey, request)
 	}
+	return srv.createBid(ctx, userFp, request)
+}
 
+func (srv *bidService) createBid(ctx context.Context, userFp string, request *v1.CreateBidRequest) (*v1.CreateBidResponse, error) {
 	activeSession, err := srv.SessionRepo.FindActiveSession(ctx, request.AssetId)
 	if err != nil {
 		return nil, err
 	}
+	if domain.IsSealedAuction(activeSession.ActionType) {
+		return nil, status.Errorf(codes.FailedPrecondition, "session %s is sealed, bids are placed with CommitBid", activeSession.Id)
+	}
 	srv.Log.Info("placing bid", "assetId", request.AssetId, "sessionId", activeSession.Id)
 
-	bidRequest := exchange.BidRequest{
-		UserFp:    userFp,
-		AssetId:   request.AssetId,
-		Amount:    float64(request.Amount),
-		LastUntil: request.LastUntil.AsTime(),
+	amount, err := toDecimal("amount", request.Amount)
+	if err != nil {
+		return nil, err
 	}
-
-	bid, err := srv.BidCacheClient.SaveBid(ctx, bidRequest, activeSession.Id)
+	bid, err := domain.NewBid(userFp, amount, request.AssetId, request.LastUntil.AsTime(), activeSession.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to save bid")
+		return nil, invalidArgument("invalid bid", err)
+	}
+	bid.Quantity = float64(request.Quantity)
+	if bid.Quantity == 0 {
+		bid.Quantity = 1
 	}
 
-	// broadcast new bid to every subscriber in the socket
-	// Marshal the struct into a JSON byte slice.
-	messageBytes, err := json.Marshal(bid)
+	result, err := srv.placeBid(ctx, activeSession, bid)
 	if err != nil {
-		srv.Log.Error("failed to marshal bid for websocket listeners", "bid", bid, "err", err)
-	} else {
-		srv.hub.Broadcast <- messageBytes
+		srv.Log.Error("failed to place bid", "sessionId", activeSession.Id, "err", err)
+		return nil, err
+	}
+	if !bid.Accepted {
+		// an accepted bid is queued by placeBid, before the session moves on
+		if err = srv.queueBid(ctx, bid); err != nil {
+			return nil, err
+		}
+	}
+	srv.publishBid(bid)
+	if activeSession.ActionType == domain.EnglishAuction && bid.Accepted {
+		// the bid may outbid bidders whose proxy bids bid back on their behalf
+		if leading := srv.resolveProxyBids(ctx, activeSession.Id); leading != nil {
+			result.HighestBid = leading.CurrentHighestBid
+		}
 	}
 
-	return &v1.CreateBidResponse{
+	response := &v1.CreateBidResponse{
 		Bid: &v1.BidResponse{
 			BidId:     bid.Id,
+			Status:    bid.Status,
 			AssetId:   bid.AssetId,
 			SessionId: activeSession.Id,
 			LastUntil: request.LastUntil,
-			Amount:    float32(bid.Amount),
+			Amount:    toMoney(bid.Amount),
 			Quantity:  float32(bid.Quantity),
 		},
-	}, nil
+		CurrentHighestBid: toMoney(result.HighestBid),
+	}
+	if result.Reason != "" {
+		response.RejectionReason = &result.Reason
+	}
+	if activeSession.ActionType == domain.FixedPriceAuction && bid.Accepted {
+		remaining := float32(result.RemainingQuantity)
+		response.RemainingQuantity = &remaining
+	}
+	return response, nil
+}
+
+// queueBid queues the bid to be persisted.
+func (srv *bidService) queueBid(ctx context.Context, bid *domain.Bid) error {
+	err := srv.BidCacheClient.SaveBid(ctx, bid)
+	if err != nil {
+		srv.Log.Error("failed to save bid", "sessionId", bid.SessionId, "bidId", bid.Id, "err", err)
+		return status.Errorf(codes.Internal, "failed to save bid")
+	}
+	return nil
+}
+
+// publishBid broadcasts the bid to the socket subscribers of its asset and session.
+func (srv *bidService) publishBid(bid *domain.Bid) {
+	// Marshal the struct into a JSON byte slice.
+	messageBytes, err := json.Marshal(bid)
+	if err != nil {
+		srv.Log.Error("failed to marshal bid for websocket listeners", "bid", bid, "err", err)
+		return
+	}
+	srv.hub.Publish(socket.NewMessage(socket.BidPlacedEvent, bid.AssetId, bid.SessionId, messageBytes))
+}
+
+// placeBid evaluates the bid with the engine of the session's auction type and marks it accepted or rejected.
+// What an accepted bid changes on the session is applied with a compare-and-set, so when a concurrent bid wins the race
+// the session is reloaded and the bid evaluated again against the session's new state. An accepted bid is queued to be
+// persisted before anything else follows from it (the session ending or being extended), and what it changed on the
+// session is reverted when it can't be queued: the session is never led by a bid that exists nowhere.
+func (srv *bidService) placeBid(ctx context.Context, session *domain.Session, bid *domain.Bid) (auction.Result, error) {
+	engine, ok := auction.NewEngine(session.ActionType)
+	if !ok {
+		// bids of this auction type are not decided on placement, they stay pending
+		return auction.Result{HighestBid: session.CurrentHighestBid}, nil
+	}
+
+	for attempt := 0; attempt < maxPlaceBidAttempts; attempt++ {
+		result := engine.Evaluate(*session, *bid)
+		if !result.Accepted {
+			bid.Reject()
+			return result, nil
+		}
+
+		updated, err := srv.applyResult(ctx, session, bid, &result)
+		if err != nil {
+			return result, err
+		}
+		if updated {
+			bid.Accept()
+			if err := srv.queueBid(ctx, bid); err != nil {
+				srv.revertResult(ctx, session, bid, result)
+				return result, err
+			}
+			switch {
+			case result.ClosesSession:
+				srv.endSession(ctx, session, domain.ClosedSession, bid.Timestamp)
+			case result.Units > 0 && result.RemainingQuantity == 0:
+				srv.endSession(ctx, session, domain.CompletedSession, bid.Timestamp)
+			case result.Units == 0:
+				srv.softClose(ctx, session, bid)
+			}
+			return result, nil
+		}
+
+		session, err = srv.SessionRepo.FindById(ctx, session.Id)
+		if err != nil {
+			return result, status.Errorf(codes.Internal, "failed to reload session")
+		}
+		// the session was closed, cancelled or ended since it was read, the bid can't change it anymore
+		if session.Status != domain.ActiveSession || !time.Now().Before(session.EndTime) {
+			bid.Reject()
+			return auction.Result{
+				HighestBid: session.CurrentHighestBid,
+				Reason:     fmt.Sprintf("session %s is no longer running", session.Id),
+			}, nil
+		}
+	}
+	return auction.Result{}, status.Errorf(codes.Aborted, "too many concurrent bids on session, retry the bid")
+}
+
+// applyResult persists what the accepted bid changes on the session. It returns false when a concurrent bid changed
+// the session first.
+func (srv *bidService) applyResult(ctx context.Context, session *domain.Session, bid *domain.Bid, result *auction.Result) (bool, error) {
+	if result.Units > 0 {
+		remaining, taken, err := srv.SessionRepo.TakeInventory(ctx, session.Id, result.Units)
+		if err != nil {
+			return false, status.Errorf(codes.Internal, "failed to take session inventory")
+		}
+		if !taken {
+			return false, nil
+		}
+		result.RemainingQuantity = remaining
+		return true, nil
+	}
+
+	if session.ActionType == domain.MultiUnitAuction && session.HighestBidId != "" &&
+		result.HighestBid.LessThanOrEqual(session.CurrentHighestBid) {
+		// a bid below the highest unit price, the session's leading bid stays as it is. The bids of single-winner
+		// auctions always go through the compare-and-set, an accepted bid leads.
+		return true, nil
+	}
+	updated, err := srv.SessionRepo.UpdateHighestBid(ctx, session.Id, session.CurrentHighestBid, result.HighestBid, bid)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "failed to update session highest bid")
+	}
+	return updated, nil
+}
+
+// revertResult undoes what applyResult changed on the session for the bid that couldn't be queued: the units it took
+// are returned, or the lead goes back to the session's previous leading bid unless a later bid took it meanwhile.
+// A failure is only logged, the bid is failed either way.
+func (srv *bidService) revertResult(ctx context.Context, session *domain.Session, bid *domain.Bid, result auction.Result) {
+	if result.Units > 0 {
+		if err := srv.SessionRepo.ReturnInventory(ctx, session.Id, result.Units); err != nil {
+			srv.Log.Error("failed to return inventory of unsaved bid", "sessionId", session.Id, "bidId", bid.Id, "err", err)
+		}
+		return
+	}
+	restored, err := srv.SessionRepo.RestoreHighestBid(ctx, *session, bid.Id)
+	if err != nil {
+		srv.Log.Error("failed to restore highest bid of session after unsaved bid", "sessionId", session.Id,
+			"bidId", bid.Id, "err", err)
+		return
+	}
+	if restored {
+		srv.Log.Warn("restored highest bid of session after unsaved bid", "sessionId", session.Id, "bidId", bid.Id)
+	}
+}
+
+// softClose extends the session when the accepted bid was placed within its soft close window. The window is checked
+// against the session as it's now, reloaded, and the extension is a compare-and-set on the session's end time: when a
+// concurrent bid extended the session first, the bid is checked again against the new end time. The bid is already
+// accepted, so a failure is only logged.
+func (srv *bidService) softClose(ctx context.Context, session *domain.Session, bid *domain.Bid) {
+	if session.SoftCloseSeconds <= 0 || session.ExtensionSeconds <= 0 {
+		return
+	}
+	for attempt := 0; attempt < maxPlaceBidAttempts; attempt++ {
+		current, err := srv.SessionRepo.FindById(ctx, session.Id)
+		if err != nil {
+			srv.Log.Error("failed to reload session to extend", "sessionId", session.Id, "bidId", bid.Id, "err", err)
+			return
+		}
+		if current.Status != domain.ActiveSession {
+			return
+		}
+		extension, ok := current.SoftClose(bid.Id, bid.Timestamp)
+		if !ok {
+			return
+		}
+		updated, err := srv.SessionRepo.ExtendEndTime(ctx, extension)
+		if err != nil {
+			srv.Log.Error("failed to extend session", "sessionId", session.Id, "err", err)
+			return
+		}
+		if updated {
+			srv.publishExtension(extension)
+			return
+		}
+	}
+	srv.Log.Error("session not extended, too many concurrent extensions", "sessionId", session.Id, "bidId", bid.Id)
+}
+
+// publishExtension broadcasts the extension to the socket subscribers of the session's asset and session.
+func (srv *bidService) publishExtension(extension domain.SessionExtension) {
+	srv.Log.Info("extended session", "sessionId", extension.SessionId, "endTime", extension.EndTime,
+		"extendedSeconds", extension.ExtendedSeconds)
+
+	messageBytes, err := json.Marshal(extension)
+	if err != nil {
+		srv.Log.Error("failed to marshal session extension for websocket listeners", "sessionId", extension.SessionId, "err", err)
+	} else {
+		srv.hub.Publish(socket.NewMessage(socket.SessionExtendedEvent, extension.AssetId, extension.SessionId, messageBytes))
+	}
+}
+
+// endSession ends the session once a bid sold it. The bid is already accepted, so a failure is only logged.
+func (srv *bidService) endSession(ctx context.Context, session *domain.Session, sessionStatus string, endTime time.Time) {
+	ended := *session
+	_, err := srv.lifecycle.Transition(ctx, &ended, sessionStatus, endTime)
+	if err != nil {
+		srv.Log.Error("failed to end session after accepted bid", "sessionId", session.Id, "status", sessionStatus, "err", err)
+	}
 }
 
 func (srv *bidService) GetUserBid(ctx context.Context, request *v1.GetUserBidRequest) (*v1.GetUserBidResponse, error) {
-	if request.AssetId == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "assetId is required")
+	userFp, err := userFpFromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
-	if request.Offset < 0 {
-		return nil, status.Errorf(codes.InvalidArgument, "offset must be greater than or equal to 0")
+	// a user only lists their own bids
+	if request.UserFp != "" && request.UserFp != userFp {
+		return nil, status.Errorf(codes.PermissionDenied, "only the user's own bids can be listed")
+	}
+	if request.AssetId == "" {
+		return nil, invalidField("asset_id", "assetId is required")
 	}
 	if request.Limit < 0 || request.Limit > 100 {
-		return nil, status.Errorf(codes.InvalidArgument, "limit must be less than or equal to 100")
+		return nil, invalidField("limit", "limit must be less than or equal to 100")
+	}
+	limit := request.Limit
+	if limit == 0 {
+		limit = defaultBidPageSize
+	}
+	cursor, err := decodeBidCursor(request.Cursor)
+	if err != nil {
+		return nil, err
 	}
 
-	bids, err := srv.BidRepo.FetchBidsByUserFp(ctx, request.Offset, request.Limit, request.UserFp)
+	bids, err := srv.BidRepo.FetchBidsByUserFp(ctx, userFp, request.AssetId, cursor, limit)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to fetch bids")
 	}
-	var bidResponses []*v1.BidResponse
-	for _, bid := range bids {
-		bidResponses = append(bidResponses, &v1.BidResponse{
-			BidId:     bid.Id,
-			AssetId:   bid.AssetId,
-			SessionId: bid.SessionId,
-			Amount:    float32(bid.Amount),
-			Quantity:  float32(bid.Quantity),
-			LastUntil: timestamppb.New(bid.LastUntil),
-		})
+	total, err := srv.BidRepo.CountBidsByUserFp(ctx, userFp, request.AssetId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count bids")
 	}
 	return &v1.GetUserBidResponse{
-		Bids:         bidResponses,
-		Offset:       request.Offset,
+		Bids:         toBidResponses(bids),
 		RowCount:     int64(len(bids)),
-		TotalResults: 0, // TODO fetch actual total results
+		TotalResults: total,
+		NextCursor:   nextBidCursor(bids, limit),
 	}, nil
 }
 
 func (srv *bidService) StreamOpenBids(req *v1.StreamOpenBidsRequest, srvStream grpc.ServerStreamingServer[v1.StreamOpenBidsResponse]) error {
 	if req.AssetId == "" {
-		return status.Errorf(codes.InvalidArgument, "assetId is required")
-	}
-	if req.Offset < 0 {
-		return status.Errorf(codes.InvalidArgument, "offset must be greater than or equal to 0")
+		return invalidField("asset_id", "assetId is required")
 	}
 	if req.Limit < 0 || req.Limit > 200 {
-		return status.Errorf(codes.InvalidArgument, "limit must be less than or equal to 200")
+		return invalidField("limit", "limit must be less than or equal to 200")
 	}
-
 	limit := req.Limit
-	offset := req.Offset
+	if limit == 0 {
+		limit = defaultBidPageSize
+	}
+	cursor, err := decodeBidCursor(req.Cursor)
+	if err != nil {
+		return err
+	}
+
 	activeSession, err := srv.SessionRepo.FindActiveSession(srvStream.Context(), req.AssetId)
 	if err != nil {
 		return err
@@ -145,7 +367,27 @@ func (srv *bidService) StreamOpenBids(req *v1.StreamOpenBidsRequest, srvStream g
 	if activeSession == nil {
 		return status.Errorf(codes.NotFound, "no active session found for assetId=%s", req.AssetId)
 	}
-	srv.Log.Info("streaming open bids", "assetId", req.AssetId, "sessionId", activeSession.Id)
+	if domain.IsSealedAuction(activeSession.ActionType) {
+		return status.Errorf(codes.FailedPrecondition, "bids of sealed session %s are not open", activeSession.Id)
+	}
+	srv.Log.Info("streaming open bids", "assetId", req.AssetId, "sessionId", activeSession.Id, "follow", req.Follow)
+
+	// subscribe before the backfill, so no bid placed while it runs is missed
+	var subscription *redis.BidSubscription
+	if req.Follow {
+		subscription, err = srv.BidCacheClient.SubscribeSessionBids(srvStream.Context(), activeSession.Id)
+		if err != nil {
+			srv.Log.Error("failed to subscribe to session bids", "sessionId", activeSession.Id, "err", err)
+			return status.Errorf(codes.Internal, "failed to follow bids")
+		}
+		defer subscription.Close()
+	}
+	sent := make(map[string]struct{})
+
+	total, err := srv.BidRepo.CountSessionBids(srvStream.Context(), req.AssetId, activeSession.Id)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to count bids")
+	}
 
 	for {
 		// Check if the client context is canceled (e.g., a client disconnected)
@@ -154,49 +396,187 @@ func (srv *bidService) StreamOpenBids(req *v1.StreamOpenBidsRequest, srvStream g
 		}
 
 		pgCtx, cancelPgCtx := context.WithTimeout(srvStream.Context(), 10*time.Second)
-		bids, err := srv.BidRepo.FetchBidsByAssetIdAndSessionId(pgCtx, offset, limit, req.AssetId, activeSession.Id)
+		bids, err := srv.BidRepo.FetchSessionBids(pgCtx, req.AssetId, activeSession.Id, cursor, limit)
 		if err != nil {
 			cancelPgCtx()
 			return status.Errorf(codes.Internal, "failed to fetch bids")
 		}
 		cancelPgCtx()
 
-		// if there are no more bids, stop streaming and return
-		// TODO: Should we sleep or exist?
-		if len(bids) == 0 {
-			return nil
-		}
-
-		// Send the response message over the stream.
-		var bidResponses []*v1.BidResponse
+		nextCursor := nextBidCursor(bids, limit)
 		for _, bid := range bids {
-			bidResponses = append(bidResponses, &v1.BidResponse{
-				BidId:     bid.Id,
-				AssetId:   bid.AssetId,
-				SessionId: bid.SessionId,
-				Amount:    float32(bid.Amount),
-				Quantity:  float32(bid.Quantity),
-				LastUntil: timestamppb.New(bid.LastUntil),
+			sent[bid.Id] = struct{}{}
+		}
+		if len(bids) > 0 {
+			// Send the response message over the stream.
+			err = srvStream.Send(&v1.StreamOpenBidsResponse{
+				Bids:         toBidResponses(bids),
+				RowCount:     int64(len(bids)),
+				TotalResults: total,
+				NextCursor:   nextCursor,
 			})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to send bid response")
+			}
 		}
 
-		// update offset value
-		offset += limit
-
-		err = srvStream.Send(&v1.StreamOpenBidsResponse{
-			Bids: bidResponses,
-		})
+		// the last page was sent
+		if nextCursor == "" {
+			if !req.Follow {
+				return nil
+			}
+			return srv.followSessionBids(srvStream, activeSession.Id, subscription, sent, total)
+		}
+		cursor, err = decodeBidCursor(&nextCursor)
 		if err != nil {
-			return status.Errorf(codes.Internal, "failed to send bid response")
+			return err
 		}
 	}
 }
 
-func NewBidService(log slog.Logger, bidCache redis.BidCache, BidRepo postgres.BidRepository, hub *socket.Hub) v1.BidServiceServer {
+func toBidResponses(bids []domain.Bid) []*v1.BidResponse {
+	bidResponses := make([]*v1.BidResponse, 0, len(bids))
+	for _, bid := range bids {
+		bidResponses = append(bidResponses, &v1.BidResponse{
+			BidId:     bid.Id,
+			AssetId:   bid.AssetId,
+			SessionId: bid.SessionId,
+			Status:    bid.Status,
+			Amount:    toMoney(bid.Amount),
+			Quantity:  float32(bid.Quantity),
+			LastUntil: timestamppb.New(bid.LastUntil),
+		})
+	}
+	return bidResponses
+}
+
+// CommitBid stores the hash of a sealed bid while the session runs. The bid itself stays unknown until it's revealed
+// with RevealBid once the session has ended.
+func (srv *bidService) CommitBid(ctx context.Context, request *v1.CommitBidRequest) (*v1.CommitBidResponse, error) {
+	userFp, err := userFpFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	activeSession, err := srv.SessionRepo.FindActiveSession(ctx, request.AssetId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no active session found for assetId=%s", request.AssetId)
+	}
+	if !domain.IsSealedAuction(activeSession.ActionType) {
+		return nil, status.Errorf(codes.FailedPrecondition, "session %s is not sealed, bids are placed with CreateBid", activeSession.Id)
+	}
+	if time.Now().Before(activeSession.StartTime) {
+		return nil, status.Errorf(codes.FailedPrecondition, "session %s has not started", activeSession.Id)
+	}
+
+	commitment, err := domain.NewBidCommitment(userFp, request.AssetId, activeSession.Id, request.Commitment)
+	if err != nil {
+		return nil, invalidArgument("invalid commitment", err)
+	}
+	commitmentId, err := srv.CommitmentRepo.Save(ctx, commitment)
+	if err != nil {
+		srv.Log.Error("failed to save bid commitment", "sessionId", activeSession.Id, "err", err)
+		return nil, status.Errorf(codes.Internal, "failed to save bid commitment")
+	}
+	srv.Log.Info("committed sealed bid", "assetId", request.AssetId, "sessionId", activeSession.Id, "commitmentId", commitmentId)
+
+	return &v1.CommitBidResponse{
+		AssetId:        request.AssetId,
+		CommitmentId:   commitmentId,
+		SessionId:      activeSession.Id,
+		CommittedAt:    timestamppb.New(commitment.CommittedAt),
+		RevealStartsAt: timestamppb.New(activeSession.EndTime),
+		RevealEndsAt:   timestamppb.New(activeSession.RevealEndTime()),
+	}, nil
+}
+
+// RevealBid opens the caller's committed bid once the session has ended. Only reveals matching their commitment
+// become bids of the session.
+func (srv *bidService) RevealBid(ctx context.Context, request *v1.RevealBidRequest) (*v1.RevealBidResponse, error) {
+	userFp, err := userFpFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := srv.SessionRepo.FindById(ctx, request.SessionId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "session %s not found", request.SessionId)
+	}
+	if !domain.IsSealedAuction(session.ActionType) {
+		return nil, status.Errorf(codes.FailedPrecondition, "session %s is not sealed", session.Id)
+	}
+	now := time.Now()
+	if now.Before(session.EndTime) {
+		return nil, status.Errorf(codes.FailedPrecondition, "reveal of session %s starts at %s", session.Id, session.EndTime)
+	}
+	if !now.Before(session.RevealEndTime()) {
+		return nil, status.Errorf(codes.FailedPrecondition, "reveal of session %s ended at %s", session.Id, session.RevealEndTime())
+	}
+
+	commitment, err := srv.CommitmentRepo.FindBySessionAndBidder(ctx, session.Id, userFp)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch bid commitment")
+	}
+	if commitment == nil {
+		return nil, status.Errorf(codes.NotFound, "no bid committed in session %s", session.Id)
+	}
+	if commitment.Status == domain.RevealedBid {
+		return nil, status.Errorf(codes.AlreadyExists, "bid of session %s is already revealed", session.Id)
+	}
+
+	amount, err := toDecimal("amount", request.Amount)
+	if err != nil {
+		return nil, err
+	}
+	// the amount is hashed in its shortest decimal form, e.g. 10.5 whether the client sent 10.5 or 10.50
+	err = commitment.Reveal(amount, amount.String(), request.Nonce, now)
+	if err != nil {
+		srv.Log.Warn("rejected bid reveal", "sessionId", session.Id, "commitmentId", commitment.Id, "err", err)
+		return nil, invalidArgument("reveal rejected", err)
+	}
+
+	bid, err := domain.NewBid(userFp, commitment.Amount, session.AssetId, session.RevealEndTime(), session.Id)
+	if err != nil {
+		return nil, invalidArgument("invalid bid", err)
+	}
+	commitment.BidId = bid.Id
+	revealed, err := srv.CommitmentRepo.MarkRevealed(ctx, commitment)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to reveal bid")
+	}
+	if !revealed {
+		return nil, status.Errorf(codes.AlreadyExists, "bid of session %s is already revealed", session.Id)
+	}
+
+	err = srv.BidCacheClient.SaveBid(ctx, bid)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save bid")
+	}
+	srv.Log.Info("revealed sealed bid", "sessionId", session.Id, "commitmentId", commitment.Id, "bidId", bid.Id)
+
+	return &v1.RevealBidResponse{
+		Bid: &v1.BidResponse{
+			BidId:     bid.Id,
+			Status:    bid.Status,
+			AssetId:   bid.AssetId,
+			SessionId: bid.SessionId,
+			Amount:    toMoney(bid.Amount),
+			Quantity:  float32(bid.Quantity),
+			LastUntil: timestamppb.New(bid.LastUntil),
+		},
+	}, nil
+}
+
+func NewBidService(log slog.Logger, cacheClients redis.CacheClients, repos postgres.Repositories, hub *socket.Hub, lifecycle service.SessionLifecycle) v1.BidServiceServer {
 	return &bidService{
-		hub:            hub,
-		Log:            log,
-		BidRepo:        BidRepo,
-		BidCacheClient: bidCache,
+		hub:              hub,
+		lifecycle:        lifecycle,
+		Log:              log,
+		BidCacheClient:   cacheClients.BidClient,
+		IdempotencyCache: cacheClients.IdempotencyClient,
+		BidRepo:          repos.BidRepository,
+		SessionRepo:      repos.SessionRepository,
+		CommitmentRepo:   repos.CommitmentRepository,
+		ProxyBidRepo:     repos.ProxyBidRepository,
 	}
 }
@@ -0,0 +1,45 @@
+package services
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"xrf197ilz35aq2/core/domain"
+	"xrf197ilz35aq2/storage/postgres"
+)
+
+// encodeBidCursor makes the opaque cursor of the page following the bid, the last bid of a page.
+func encodeBidCursor(bid domain.Bid) string {
+	keyset := fmt.Sprintf("%d:%s", bid.Timestamp.UnixMicro(), bid.Id)
+	return base64.RawURLEncoding.EncodeToString([]byte(keyset))
+}
+
+// decodeBidCursor reads a cursor made by encodeBidCursor, nil for no cursor.
+func decodeBidCursor(cursor *string) (*postgres.BidCursor, error) {
+	if cursor == nil || *cursor == "" {
+		return nil, nil
+	}
+	keyset, err := base64.RawURLEncoding.DecodeString(*cursor)
+	if err != nil {
+		return nil, invalidField("cursor", "invalid cursor")
+	}
+	placedAt, id, ok := strings.Cut(string(keyset), ":")
+	if !ok || id == "" {
+		return nil, invalidField("cursor", "invalid cursor")
+	}
+	micros, err := strconv.ParseInt(placedAt, 10, 64)
+	if err != nil {
+		return nil, invalidField("cursor", "invalid cursor")
+	}
+	return &postgres.BidCursor{PlacedAt: time.UnixMicro(micros), Id: id}, nil
+}
+
+// nextBidCursor is the cursor of the page after bids, empty when bids is the last page.
+func nextBidCursor(bids []domain.Bid, limit int64) string {
+	if int64(len(bids)) < limit || len(bids) == 0 {
+		return ""
+	}
+	return encodeBidCursor(bids[len(bids)-1])
+}
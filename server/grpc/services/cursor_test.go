@@ -0,0 +1,95 @@
+package services
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+	"xrf197ilz35aq2/core/domain"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBidCursorRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		bid  domain.Bid
+	}{
+		{name: "utc", bid: domain.Bid{Id: "bid-1", Timestamp: time.Date(2026, 3, 1, 12, 30, 0, 123456000, time.UTC)}},
+		{name: "sub-microsecond is dropped", bid: domain.Bid{Id: "bid-2", Timestamp: time.Date(2026, 3, 1, 12, 30, 0, 123456789, time.UTC)}},
+		{name: "before the epoch", bid: domain.Bid{Id: "bid-3", Timestamp: time.Date(1969, 12, 31, 23, 59, 59, 0, time.UTC)}},
+		{name: "id with a colon", bid: domain.Bid{Id: "bid:4", Timestamp: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cursor := encodeBidCursor(test.bid)
+			decoded, err := decodeBidCursor(&cursor)
+			if err != nil {
+				t.Fatalf("decoding %q failed: %v", cursor, err)
+			}
+			if decoded.Id != test.bid.Id {
+				t.Errorf("id = %s, want %s", decoded.Id, test.bid.Id)
+			}
+			if want := test.bid.Timestamp.Truncate(time.Microsecond); !decoded.PlacedAt.Equal(want) {
+				t.Errorf("placed at = %s, want %s", decoded.PlacedAt, want)
+			}
+		})
+	}
+}
+
+func TestDecodeBidCursor(t *testing.T) {
+	encode := func(keyset string) *string {
+		cursor := base64.RawURLEncoding.EncodeToString([]byte(keyset))
+		return &cursor
+	}
+	empty, notBase64 := "", "not base64!"
+	tests := []struct {
+		name    string
+		cursor  *string
+		invalid bool
+	}{
+		{name: "no cursor", cursor: nil},
+		{name: "empty cursor", cursor: &empty},
+		{name: "not base64", cursor: &notBase64, invalid: true},
+		{name: "no separator", cursor: encode("1700000000000000"), invalid: true},
+		{name: "no id", cursor: encode("1700000000000000:"), invalid: true},
+		{name: "timestamp not a number", cursor: encode("yesterday:bid-1"), invalid: true},
+		{name: "timestamp overflows", cursor: encode("99999999999999999999:bid-1"), invalid: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			decoded, err := decodeBidCursor(test.cursor)
+			if !test.invalid {
+				if err != nil || decoded != nil {
+					t.Fatalf("got %+v, %v, want no cursor", decoded, err)
+				}
+				return
+			}
+			if code := status.Code(err); code != codes.InvalidArgument {
+				t.Fatalf("code = %s, want %s", code, codes.InvalidArgument)
+			}
+		})
+	}
+}
+
+func TestNextBidCursor(t *testing.T) {
+	placedAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	bids := []domain.Bid{{Id: "bid-1", Timestamp: placedAt}, {Id: "bid-2", Timestamp: placedAt.Add(time.Second)}}
+	tests := []struct {
+		name  string
+		bids  []domain.Bid
+		limit int64
+		want  string
+	}{
+		{name: "no bids", bids: nil, limit: 2, want: ""},
+		{name: "last page", bids: bids, limit: 3, want: ""},
+		{name: "full page", bids: bids, limit: 2, want: encodeBidCursor(bids[1])},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := nextBidCursor(test.bids, test.limit); got != test.want {
+				t.Errorf("cursor = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
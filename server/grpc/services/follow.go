@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"time"
+	"xrf197ilz35aq2/core/domain"
+	v1 "xrf197ilz35aq2/gen/go/service/v1"
+	"xrf197ilz35aq2/storage/redis"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// how often a followed session is checked for having closed
+const followSessionCheckInterval = time.Second
+
+// followSessionBids live-tails a session once its persisted bids were streamed. The accepted bids still waiting in
+// the cache to be persisted are sent first, then every bid placed (or cancelled) as it happens, until the session closes.
+// sent holds the ids of the bids streamed so far, a bid is only sent again when its status changed.
+func (srv *bidService) followSessionBids(srvStream grpc.ServerStreamingServer[v1.StreamOpenBidsResponse], sessionId string,
+	subscription *redis.BidSubscription, sent map[string]struct{}, total int64) error {
+	ctx := srvStream.Context()
+
+	cachedBids, err := srv.BidCacheClient.SessionBids(ctx, sessionId)
+	if err != nil {
+		srv.Log.Error("failed to fetch cached session bids", "sessionId", sessionId, "err", err)
+		return status.Errorf(codes.Internal, "failed to fetch bids")
+	}
+	pending := make([]domain.Bid, 0, len(cachedBids))
+	for _, bid := range cachedBids {
+		if _, ok := sent[bid.Id]; !ok {
+			pending = append(pending, bid)
+		}
+	}
+	if err = srv.sendFollowedBids(srvStream, pending, sent, &total); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(followSessionCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return status.Errorf(codes.Canceled, "stream canceled")
+		case bid, ok := <-subscription.Bids():
+			if !ok {
+				return status.Errorf(codes.Unavailable, "bid subscription closed")
+			}
+			if _, seen := sent[bid.Id]; seen && bid.Status != domain.CancelledBid {
+				continue
+			}
+			if err = srv.sendFollowedBids(srvStream, []domain.Bid{bid}, sent, &total); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			closed, err := srv.sessionClosed(ctx, sessionId)
+			if err != nil {
+				srv.Log.Error("failed to check followed session", "sessionId", sessionId, "err", err)
+				return status.Errorf(codes.Internal, "failed to follow bids")
+			}
+			if closed {
+				srv.Log.Info("followed session closed, ending bid stream", "sessionId", sessionId)
+				return nil
+			}
+		}
+	}
+}
+
+func (srv *bidService) sendFollowedBids(srvStream grpc.ServerStreamingServer[v1.StreamOpenBidsResponse], bids []domain.Bid,
+	sent map[string]struct{}, total *int64) error {
+	if len(bids) == 0 {
+		return nil
+	}
+	for _, bid := range bids {
+		if _, seen := sent[bid.Id]; !seen {
+			sent[bid.Id] = struct{}{}
+			*total++
+		}
+	}
+	err := srvStream.Send(&v1.StreamOpenBidsResponse{
+		Bids:         toBidResponses(bids),
+		RowCount:     int64(len(bids)),
+		TotalResults: *total,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to send bid response")
+	}
+	return nil
+}
+
+// sessionClosed reports whether the session stopped taking bids, its end_time may have been extended by a soft close
+func (srv *bidService) sessionClosed(ctx context.Context, sessionId string) (bool, error) {
+	session, err := srv.SessionRepo.FindById(ctx, sessionId)
+	if err != nil {
+		return false, err
+	}
+	return session.Status != domain.ActiveSession || !time.Now().Before(session.EndTime), nil
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	v1 "xrf197ilz35aq2/gen/go/service/v1"
+	"xrf197ilz35aq2/storage/redis"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// IdempotencyHeader carries the idempotency key of a request, when it isn't set in the request itself.
+const IdempotencyHeader = "x-idempotency-key"
+
+const maxIdempotencyKeyLength = 128
+
+// maxCompleteAttempts is how many times keeping the response of a placed bid for its retries is tried.
+const maxCompleteAttempts = 3
+
+// idempotencyKeyFromRequest returns the idempotency key of the bid, "" when the client sent none.
+func idempotencyKeyFromRequest(ctx context.Context, request *v1.CreateBidRequest) (string, error) {
+	key := request.GetIdempotencyKey()
+	if key == "" {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(IdempotencyHeader); len(values) > 0 {
+				key = values[0]
+			}
+		}
+	}
+	if len(key) > maxIdempotencyKeyLength {
+		return "", invalidField("idempotency_key", "idempotency key must be at most %d characters", maxIdempotencyKeyLength)
+	}
+	return key, nil
+}
+
+// bidFingerprint identifies the payload of the bid, the idempotency key aside.
+func bidFingerprint(request *v1.CreateBidRequest) (string, error) {
+	payload := proto.Clone(request).(*v1.CreateBidRequest)
+	payload.IdempotencyKey = nil
+	payloadBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(payloadBytes)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// createBidOnce places the bid once per idempotency key of the user. A retry with the same payload gets the response of
+// the first attempt, a retry with another payload is rejected with codes.AlreadyExists. A failed attempt frees the key.
+func (srv *bidService) createBidOnce(ctx context.Context, userFp, key string, request *v1.CreateBidRequest) (*v1.CreateBidResponse, error) {
+	fingerprint, err := bidFingerprint(request)
+	if err != nil {
+		srv.Log.Error("failed to fingerprint bid", "err", err)
+		return nil, status.Errorf(codes.Internal, "failed to place bid")
+	}
+
+	existing, err := srv.IdempotencyCache.Reserve(ctx, userFp, key, fingerprint)
+	if err != nil {
+		srv.Log.Error("failed to reserve idempotency key", "userFp", userFp, "err", err)
+		return nil, status.Errorf(codes.Unavailable, "failed to reserve idempotency key, retry")
+	}
+	if existing != nil {
+		if existing.Fingerprint != fingerprint {
+			return nil, status.Errorf(codes.AlreadyExists, "idempotency key %s was used for another bid", key)
+		}
+		if !existing.Completed() {
+			return nil, status.Errorf(codes.Aborted, "a bid with idempotency key %s is being placed, retry", key)
+		}
+		var response v1.CreateBidResponse
+		if err = proto.Unmarshal(existing.Response, &response); err != nil {
+			srv.Log.Error("failed to unmarshal idempotent bid response", "userFp", userFp, "err", err)
+			return nil, status.Errorf(codes.Internal, "failed to place bid")
+		}
+		srv.Log.Info("replaying idempotent bid", "userFp", userFp, "bidId", response.GetBid().GetBidId())
+		return &response, nil
+	}
+
+	response, err := srv.createBid(ctx, userFp, request)
+	if err != nil {
+		if releaseErr := srv.IdempotencyCache.Release(ctx, userFp, key); releaseErr != nil {
+			srv.Log.Error("failed to release idempotency key", "userFp", userFp, "err", releaseErr)
+		}
+		return nil, err
+	}
+
+	// the bid is placed, the key stays reserved as long as the response would be kept: when the response can't be
+	// kept, retries are answered codes.Aborted instead of placing the bid again. Only when both fail does the
+	// reservation expire, and a retry after that places another bid.
+	if err := srv.IdempotencyCache.Hold(ctx, userFp, key); err != nil {
+		srv.Log.Error("failed to hold idempotency key", "userFp", userFp, "bidId", response.Bid.BidId, "err", err)
+	}
+	responseBytes, err := proto.Marshal(response)
+	if err != nil {
+		srv.Log.Error("failed to marshal idempotent bid response", "userFp", userFp, "bidId", response.Bid.BidId, "err", err)
+		return response, nil
+	}
+	record := redis.IdempotencyRecord{Fingerprint: fingerprint, Response: responseBytes}
+	for attempt := 1; attempt <= maxCompleteAttempts; attempt++ {
+		if err = srv.IdempotencyCache.Complete(ctx, userFp, key, record); err == nil {
+			return response, nil
+		}
+		srv.Log.Warn("failed to complete idempotency key", "userFp", userFp, "bidId", response.Bid.BidId,
+			"attempt", attempt, "err", err)
+	}
+	srv.Log.Error("idempotency key not completed, retries are answered codes.Aborted", "userFp", userFp,
+		"bidId", response.Bid.BidId, "err", err)
+	return response, nil
+}
@@ -0,0 +1,47 @@
+package services
+
+import (
+	moneyV1 "xrf197ilz35aq2/gen/go/money/v1"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// moneyScale is the number of fractional digits money is stored with (NUMERIC(38, 9)), an amount with more digits
+	// would be rounded by the database.
+	moneyScale = 9
+	// moneyPrecision is the number of digits money is stored with, moneyPrecision-moneyScale of them before the point.
+	moneyPrecision = 38
+	// maxMoneyLength is the length of the longest amount text read, every NUMERIC(38, 9) amount fits in it.
+	maxMoneyLength = 64
+)
+
+// toDecimal reads the money field of a request, an unset amount is zero. The amount is checked against the bounds of
+// NUMERIC(38, 9) before any arithmetic: rescaling a decimal with a huge exponent computes a power of ten as large.
+func toDecimal(field string, amount *moneyV1.Decimal) (decimal.Decimal, error) {
+	if amount == nil || amount.Value == "" {
+		return decimal.Zero, nil
+	}
+	if len(amount.Value) > maxMoneyLength {
+		return decimal.Zero, invalidField(field, "amount must be at most %d characters long", maxMoneyLength)
+	}
+	value, err := decimal.NewFromString(amount.Value)
+	if err != nil {
+		return decimal.Zero, invalidField(field, "%s is not a decimal amount", amount.Value)
+	}
+	exponent := int(value.Exponent())
+	if exponent < -moneyScale {
+		return decimal.Zero, invalidField(field, "%s has more than %d decimal places", amount.Value, moneyScale)
+	}
+	if exponent > moneyPrecision-moneyScale || value.NumDigits() > moneyPrecision ||
+		value.NumDigits()+exponent > moneyPrecision-moneyScale {
+		return decimal.Zero, invalidField(field, "%s has more than %d digits before the decimal point", amount.Value,
+			moneyPrecision-moneyScale)
+	}
+	return value, nil
+}
+
+// toMoney is the exact decimal text of the amount, the way responses carry money.
+func toMoney(amount decimal.Decimal) *moneyV1.Decimal {
+	return &moneyV1.Decimal{Value: amount.String()}
+}
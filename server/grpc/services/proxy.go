@@ -0,0 +1,124 @@
+package services
+
+import (
+	"context"
+	"time"
+	"xrf197ilz35aq2/core/auction"
+	"xrf197ilz35aq2/core/domain"
+	v1 "xrf197ilz35aq2/gen/go/service/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxProxyRounds bounds how many times proxy bids are resolved after a bid, concurrent bids may keep moving the session.
+const maxProxyRounds = 10
+
+// SetProxyBid stores the caller's hidden maximum for the asset's active EnglishAuction session and bids on their
+// behalf right away when they don't lead the session.
+func (srv *bidService) SetProxyBid(ctx context.Context, request *v1.SetProxyBidRequest) (*v1.SetProxyBidResponse, error) {
+	userFp, err := userFpFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	activeSession, err := srv.SessionRepo.FindActiveSession(ctx, request.AssetId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no active session found for assetId=%s", request.AssetId)
+	}
+	if activeSession.ActionType != domain.EnglishAuction {
+		return nil, status.Errorf(codes.FailedPrecondition, "proxy bids are only supported by %s sessions", domain.EnglishAuction)
+	}
+	if time.Now().Before(activeSession.StartTime) {
+		return nil, status.Errorf(codes.FailedPrecondition, "session %s has not started", activeSession.Id)
+	}
+	maxAmount, err := toDecimal("max_amount", request.MaxAmount)
+	if err != nil {
+		return nil, err
+	}
+	minimumBid := auction.EnglishAuction{}.MinimumBid(*activeSession)
+	if activeSession.HighestBidderFp != userFp && maxAmount.LessThan(minimumBid) {
+		return nil, status.Errorf(codes.FailedPrecondition, "maximum amount %s is below the minimum accepted bid %s",
+			maxAmount, minimumBid)
+	}
+
+	proxy, err := domain.NewProxyBid(userFp, request.AssetId, activeSession.Id, maxAmount)
+	if err != nil {
+		return nil, invalidArgument("invalid proxy bid", err)
+	}
+	saved, err := srv.ProxyBidRepo.Save(ctx, proxy)
+	if err != nil {
+		srv.Log.Error("failed to save proxy bid", "sessionId", activeSession.Id, "err", err)
+		return nil, status.Errorf(codes.Internal, "failed to save proxy bid")
+	}
+	if !saved {
+		return nil, status.Errorf(codes.FailedPrecondition, "a proxy bid's maximum can only be raised")
+	}
+	srv.Log.Info("set proxy bid", "assetId", request.AssetId, "sessionId", activeSession.Id, "proxyId", proxy.Id)
+
+	session := srv.resolveProxyBids(ctx, activeSession.Id)
+	if session == nil {
+		session = activeSession
+	}
+	return &v1.SetProxyBidResponse{
+		ProxyId:           proxy.Id,
+		AssetId:           request.AssetId,
+		SessionId:         activeSession.Id,
+		MaxAmount:         toMoney(proxy.MaxAmount),
+		CurrentHighestBid: toMoney(session.CurrentHighestBid),
+		Leading:           session.HighestBidderFp == userFp,
+	}, nil
+}
+
+// resolveProxyBids places the bids of the session's proxy bids until none of them has to bid anymore (see
+// auction.EnglishAuction.ResolveProxies). Proxy bids are placed like any other bid, only their amounts are published.
+// It returns the session as the proxies left it, or nil when it couldn't be resolved; failures are only logged since
+// the bid that triggered the resolution is already placed.
+func (srv *bidService) resolveProxyBids(ctx context.Context, sessionId string) *domain.Session {
+	engine := auction.EnglishAuction{}
+	for round := 0; round < maxProxyRounds; round++ {
+		session, err := srv.SessionRepo.FindById(ctx, sessionId)
+		if err != nil {
+			srv.Log.Error("failed to reload session for proxy bids", "sessionId", sessionId, "err", err)
+			return nil
+		}
+		proxies, err := srv.ProxyBidRepo.FindBySession(ctx, sessionId)
+		if err != nil {
+			srv.Log.Error("failed to fetch proxy bids", "sessionId", sessionId, "err", err)
+			return nil
+		}
+
+		placements := engine.ResolveProxies(*session, proxies)
+		if len(placements) == 0 {
+			return session
+		}
+		for _, placement := range placements {
+			if err := srv.placeProxyBid(ctx, session, placement); err != nil {
+				srv.Log.Error("failed to place proxy bid", "sessionId", sessionId, "proxyId", placement.Proxy.Id, "err", err)
+				return nil
+			}
+		}
+	}
+	srv.Log.Warn("proxy bids of session didn't settle", "sessionId", sessionId, "rounds", maxProxyRounds)
+	return nil
+}
+
+func (srv *bidService) placeProxyBid(ctx context.Context, session *domain.Session, placement auction.ProxyPlacement) error {
+	proxy := placement.Proxy
+	bid, err := domain.NewBid(proxy.UserFp, placement.Amount, proxy.AssetId, session.EndTime, session.Id)
+	if err != nil {
+		return err
+	}
+	bid.Quantity = 1
+
+	if _, err := srv.placeBid(ctx, session, bid); err != nil {
+		return err
+	}
+	if !bid.Accepted {
+		// a concurrent bid moved the session, the next round resolves the proxies against its new state
+		return nil
+	}
+	srv.publishBid(bid)
+	srv.Log.Info("placed proxy bid", "sessionId", session.Id, "proxyId", proxy.Id, "bidId", bid.Id)
+	return nil
+}
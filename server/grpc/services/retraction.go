@@ -0,0 +1,212 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+	"xrf197ilz35aq2/core/auction"
+	"xrf197ilz35aq2/core/domain"
+	v1 "xrf197ilz35aq2/gen/go/service/v1"
+	"xrf197ilz35aq2/server/socket"
+	"xrf197ilz35aq2/storage/postgres"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CancelBid retracts one of the caller's bids, whether it's still queued or already persisted, when the rules of the
+// session's auction type allow it (see auction.CanRetract). Retracting the leading bid hands the lead back to the next
+// highest bid. The caller's proxy bid of the session is only deleted when the request asks for it.
+func (srv *bidService) CancelBid(ctx context.Context, request *v1.CancelBidRequest) (*v1.CancelBidResponse, error) {
+	userFp, err := userFpFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if request.BidId == "" {
+		return nil, invalidField("bid_id", "bidId is required")
+	}
+
+	bid, err := srv.findBid(ctx, request.BidId)
+	if err != nil {
+		return nil, err
+	}
+	if bid.UserFp != userFp {
+		return nil, status.Errorf(codes.PermissionDenied, "only the bidder can cancel bid %s", bid.Id)
+	}
+	session, err := srv.SessionRepo.FindById(ctx, bid.SessionId)
+	if err != nil {
+		if errors.Is(err, postgres.ErrSessionNotFound) {
+			return nil, status.Errorf(codes.NotFound, "session %s not found", bid.SessionId)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to find session")
+	}
+	if err := auction.CanRetract(*session, *bid, time.Now()); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "bid can't be cancelled: %s", err)
+	}
+
+	// the proxy bid goes first, it mustn't bid again once the bid is cancelled
+	if request.CancelProxyBid {
+		if _, err := srv.ProxyBidRepo.Delete(ctx, session.Id, userFp); err != nil {
+			srv.Log.Error("failed to delete proxy bid of cancelled bid", "bidId", bid.Id, "err", err)
+			return nil, status.Errorf(codes.Internal, "failed to cancel proxy bid")
+		}
+	}
+
+	bid.Cancel()
+	if err := srv.BidCacheClient.CancelBid(ctx, bid); err != nil {
+		srv.Log.Error("failed to cancel cached bid", "bidId", bid.Id, "err", err)
+		return nil, status.Errorf(codes.Internal, "failed to cancel bid")
+	}
+	cancelled, err := srv.BidRepo.CancelBid(ctx, bid.Id)
+	if err != nil {
+		srv.Log.Error("failed to cancel persisted bid", "bidId", bid.Id, "err", err)
+		return nil, status.Errorf(codes.Internal, "failed to cancel bid")
+	}
+	if !cancelled {
+		persisted, err := srv.BidRepo.FindById(ctx, bid.Id)
+		if err != nil {
+			srv.Log.Error("failed to fetch bid to cancel", "bidId", bid.Id, "err", err)
+			return nil, status.Errorf(codes.Internal, "failed to cancel bid")
+		}
+		// a bid still queued isn't persisted yet, the BidWorker persists it as cancelled (see BidCache.CancelBid)
+		if persisted != nil && persisted.Status != domain.CancelledBid {
+			srv.Log.Warn("persisted bid changed before it was cancelled", "bidId", bid.Id, "status", persisted.Status)
+			return nil, status.Errorf(codes.FailedPrecondition, "bid %s is %s, it can't be cancelled anymore", bid.Id,
+				persisted.Status)
+		}
+	}
+	srv.Log.Info("cancelled bid", "bidId", bid.Id, "sessionId", session.Id)
+
+	if session.HighestBidId == bid.Id {
+		session, err = srv.handBackLead(ctx, session, bid)
+		if err != nil {
+			return nil, err
+		}
+		if session.ActionType == domain.EnglishAuction {
+			if resolved := srv.resolveProxyBids(ctx, session.Id); resolved != nil {
+				session = resolved
+			}
+		}
+	}
+
+	retraction := domain.BidRetraction{
+		BidId:             bid.Id,
+		AssetId:           bid.AssetId,
+		SessionId:         bid.SessionId,
+		Status:            bid.Status,
+		CurrentHighestBid: session.CurrentHighestBid,
+	}
+	messageBytes, err := json.Marshal(retraction)
+	if err != nil {
+		srv.Log.Error("failed to marshal bid retraction for websocket listeners", "bidId", bid.Id, "err", err)
+	} else {
+		srv.hub.Publish(socket.NewMessage(socket.BidCancelledEvent, retraction.AssetId, retraction.SessionId, messageBytes))
+	}
+
+	return &v1.CancelBidResponse{
+		Bid: &v1.BidResponse{
+			BidId:     bid.Id,
+			Status:    bid.Status,
+			AssetId:   bid.AssetId,
+			SessionId: bid.SessionId,
+			Amount:    toMoney(bid.Amount),
+			Quantity:  float32(bid.Quantity),
+			LastUntil: timestamppb.New(bid.LastUntil),
+		},
+		CurrentHighestBid: toMoney(session.CurrentHighestBid),
+	}, nil
+}
+
+// findBid returns the bid from the cache while it's queued, from postgres once the BidWorker persisted it.
+func (srv *bidService) findBid(ctx context.Context, bidId string) (*domain.Bid, error) {
+	bid, err := srv.BidCacheClient.FindBid(ctx, bidId)
+	if err != nil {
+		srv.Log.Error("failed to fetch cached bid", "bidId", bidId, "err", err)
+		return nil, status.Errorf(codes.Internal, "failed to fetch bid")
+	}
+	if bid != nil {
+		return bid, nil
+	}
+	bid, err = srv.BidRepo.FindById(ctx, bidId)
+	if err != nil {
+		srv.Log.Error("failed to fetch bid", "bidId", bidId, "err", err)
+		return nil, status.Errorf(codes.Internal, "failed to fetch bid")
+	}
+	if bid == nil {
+		return nil, status.Errorf(codes.NotFound, "bid %s not found", bidId)
+	}
+	return bid, nil
+}
+
+// handBackLead recomputes the session's highest bid once its leading bid was retracted: the next highest accepted bid
+// leads, or nobody when there's none. The compare-and-set on the leading bid is retried as long as the retracted bid
+// still leads, a concurrent bid taking the lead makes the recompute moot. The lead is handed back even when the session
+// ended meanwhile, it mustn't be settled with a cancelled bid.
+func (srv *bidService) handBackLead(ctx context.Context, session *domain.Session, retracted *domain.Bid) (*domain.Session, error) {
+	for attempt := 0; attempt < maxPlaceBidAttempts; attempt++ {
+		leader, err := srv.nextHighestBid(ctx, session.Id, retracted.Id)
+		if err != nil {
+			return nil, err
+		}
+		if leader == nil {
+			leader = &domain.Bid{}
+		}
+
+		next := domain.Session{
+			Id:                session.Id,
+			CurrentHighestBid: leader.Amount,
+			HighestBidId:      leader.Id,
+			HighestBidderFp:   leader.UserFp,
+		}
+		updated, err := srv.SessionRepo.RestoreHighestBid(ctx, next, retracted.Id)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update session highest bid")
+		}
+		if updated {
+			session.CurrentHighestBid = leader.Amount
+			session.HighestBidId = leader.Id
+			session.HighestBidderFp = leader.UserFp
+			return session, nil
+		}
+
+		session, err = srv.SessionRepo.FindById(ctx, session.Id)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to reload session")
+		}
+		if session.HighestBidId != retracted.Id {
+			return session, nil
+		}
+
+	}
+	return nil, status.Errorf(codes.Aborted, "too many concurrent bids on session, the highest bid wasn't recomputed")
+}
+
+// nextHighestBid returns the session's highest accepted bid but the retracted one, or nil when there's none. The bids
+// still queued are only cached, and the cached ones expire (or are evicted) while the persisted ones stay, so the
+// highest of both is taken.
+func (srv *bidService) nextHighestBid(ctx context.Context, sessionId string, retractedId string) (*domain.Bid, error) {
+	cached, err := srv.BidCacheClient.HighestBid(ctx, sessionId)
+	if err != nil {
+		srv.Log.Error("failed to fetch next highest cached bid", "sessionId", sessionId, "err", err)
+		return nil, status.Errorf(codes.Internal, "failed to recompute session highest bid")
+	}
+	persisted, err := srv.BidRepo.FindHighestAcceptedBid(ctx, sessionId)
+	if err != nil {
+		srv.Log.Error("failed to fetch next highest bid", "sessionId", sessionId, "err", err)
+		return nil, status.Errorf(codes.Internal, "failed to recompute session highest bid")
+	}
+
+	var leader *domain.Bid
+	for _, bid := range []*domain.Bid{cached, persisted} {
+		if bid == nil || bid.Id == retractedId || bid.Status != domain.AcceptedBid {
+			continue
+		}
+		if leader == nil || bid.Amount.GreaterThan(leader.Amount) ||
+			(bid.Amount.Equal(leader.Amount) && bid.Timestamp.Before(leader.Timestamp)) {
+			leader = bid
+		}
+	}
+	return leader, nil
+}
@@ -2,8 +2,11 @@ package services
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"time"
 	"xrf197ilz35aq2/core/domain"
+	"xrf197ilz35aq2/core/service"
 	v1 "xrf197ilz35aq2/gen/go/service/session/v1"
 	"xrf197ilz35aq2/internal/exchange"
 	"xrf197ilz35aq2/storage/postgres"
@@ -14,52 +17,78 @@ import (
 )
 
 type sessionService struct {
-	log         slog.Logger
-	sessionRepo postgres.SessionRepository
+	log            slog.Logger
+	sessions       service.SessionServ
+	lifecycle      service.SessionLifecycle
+	settlement     service.SessionSettlement
+	sessionRepo    postgres.SessionRepository
+	settlementRepo postgres.SettlementRepository
 
 	v1.UnimplementedSessionServiceServer
 }
 
 func (srvc *sessionService) CreateSession(ctx context.Context, req *v1.CreateSessionRequest) (*v1.CreateSessionResponse, error) {
+	userFp, err := userFpFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	sessionName := ""
 	if req.Name != nil {
 		sessionName = *req.Name
 	}
-	sessionReq := exchange.NewSessionRequest{
-		AssetId:            req.AssetId,
-		Name:               sessionName,
-		Type:               req.AuctionType,
-		AutoExecute:        req.AutoExecute,
-		EndTime:            req.EndTime.AsTime(),
-		StartTime:          req.StartTime.AsTime(),
-		ReservePrice:       float64(req.ReservePrice),
-		BidIncrementAmount: float64(req.BidIncrementAmount),
+	reservePrice, err := toDecimal("reserve_price", req.ReservePrice)
+	if err != nil {
+		return nil, err
 	}
-
-	newSession, err := domain.NewSession(sessionReq, "")
+	bidIncrementAmount, err := toDecimal("bid_increment_amount", req.BidIncrementAmount)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create session")
+		return nil, err
+	}
+	startingPrice, err := toDecimal("starting_price", req.StartingPrice)
+	if err != nil {
+		return nil, err
+	}
+	priceStep, err := toDecimal("price_step", req.PriceStep)
+	if err != nil {
+		return nil, err
+	}
+	unitPrice, err := toDecimal("unit_price", req.UnitPrice)
+	if err != nil {
+		return nil, err
+	}
+	sessionReq := exchange.NewSessionRequest{
+		AssetId:             req.AssetId,
+		Name:                sessionName,
+		Type:                req.AuctionType,
+		AutoExecute:         req.AutoExecute,
+		EndTime:             req.EndTime.AsTime(),
+		StartTime:           req.StartTime.AsTime(),
+		ReservePrice:        reservePrice,
+		BidIncrementAmount:  bidIncrementAmount,
+		StartingPrice:       startingPrice,
+		PriceStep:           priceStep,
+		PriceStepSeconds:    req.GetPriceStepSeconds(),
+		RevealSeconds:       req.GetRevealSeconds(),
+		UnitPrice:           unitPrice,
+		AvailableQuantity:   float64(req.GetAvailableQuantity()),
+		PricingRule:         req.GetPricingRule(),
+		SoftCloseSeconds:    req.GetSoftCloseSeconds(),
+		ExtensionSeconds:    req.GetExtensionSeconds(),
+		MaxExtensionSeconds: req.GetMaxExtensionSeconds(),
 	}
 
-	createdSessionId, err := srvc.sessionRepo.Create(ctx, newSession)
-
+	newSession, err := srvc.sessions.CreateSession(ctx, sessionReq, userFp)
 	if err != nil {
+		if ok, st := validationStatus("invalid session", err); ok {
+			return nil, st
+		}
+		srvc.log.Error("failed to create session", "assetId", req.AssetId, "err", err)
 		return nil, status.Errorf(codes.Internal, "failed to create session")
 	}
+	srvc.log.Info("created session", "sessionId", newSession.Id, "assetId", newSession.AssetId, "userFp", userFp)
 	return &v1.CreateSessionResponse{
-		Session: &v1.SessionResponse{
-			SessionId:          createdSessionId,
-			Name:               &newSession.Name,
-			Status:             newSession.Status,
-			AuctionType:        newSession.ActionType,
-			AutoExecute:        newSession.AutoExecute,
-			ReservePrice:       float32(newSession.ReservePrice),
-			EndTime:            timestamppb.New(newSession.EndTime),
-			StartTime:          timestamppb.New(newSession.StartTime),
-			CreatedAt:          timestamppb.New(newSession.CreatedAt),
-			CurrentHighestBid:  float32(newSession.CurrentHighestBid),
-			BidIncrementAmount: float32(newSession.BidIncrementAmount),
-		},
+		Session: toSessionResponse(newSession),
 	}, nil
 }
 
@@ -74,25 +103,274 @@ func (srvc *sessionService) GetActiveAssetSession(ctx context.Context, req *v1.G
 	}
 
 	return &v1.GetActiveAssetSessionResponse{
-		Session: &v1.SessionResponse{
-			SessionId:          activeSession.Id,
-			Name:               &activeSession.Name,
-			Status:             activeSession.Status,
-			AuctionType:        activeSession.ActionType,
-			AutoExecute:        activeSession.AutoExecute,
-			ReservePrice:       float32(activeSession.ReservePrice),
-			EndTime:            timestamppb.New(activeSession.EndTime),
-			StartTime:          timestamppb.New(activeSession.StartTime),
-			CreatedAt:          timestamppb.New(activeSession.CreatedAt),
-			CurrentHighestBid:  float32(activeSession.CurrentHighestBid),
-			BidIncrementAmount: float32(activeSession.BidIncrementAmount),
-		},
+		Session: toSessionResponse(activeSession),
+	}, nil
+}
+
+func (srvc *sessionService) GetSession(ctx context.Context, req *v1.GetSessionRequest) (*v1.GetSessionResponse, error) {
+	session, err := srvc.findSession(ctx, req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetSessionResponse{
+		Session: toSessionResponse(session),
 	}, nil
 }
 
-func NewSessionServiceServer(log slog.Logger, sessionRepo postgres.SessionRepository) v1.SessionServiceServer {
+func (srvc *sessionService) ListSessions(ctx context.Context, req *v1.ListSessionsRequest) (*v1.ListSessionsResponse, error) {
+	if req.Offset < 0 {
+		return nil, invalidField("offset", "offset must be greater than or equal to 0")
+	}
+	if req.Limit < 0 || req.Limit > 100 {
+		return nil, invalidField("limit", "limit must be less than or equal to 100")
+	}
+	if req.Status != nil && !domain.IsValidSessionStatus(*req.Status) {
+		return nil, invalidField("status", "invalid session status %s", *req.Status)
+	}
+	if req.AuctionType != nil && !domain.IsValidAuctionType(*req.AuctionType) {
+		return nil, invalidField("auction_type", "invalid auction type %s", *req.AuctionType)
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = 20
+	}
+
+	filter := postgres.SessionFilter{
+		AssetId:     req.GetAssetId(),
+		OwnerFp:     req.GetOwnerFp(),
+		Status:      req.GetStatus(),
+		AuctionType: req.GetAuctionType(),
+	}
+	if req.From != nil {
+		from := req.From.AsTime()
+		filter.From = &from
+	}
+	if req.To != nil {
+		to := req.To.AsTime()
+		filter.To = &to
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return nil, invalidField("from", "from must be before to")
+	}
+
+	sessions, total, err := srvc.sessionRepo.FindAll(ctx, filter, req.Offset, limit)
+	if err != nil {
+		srvc.log.Error("failed to list sessions", "err", err)
+		return nil, status.Errorf(codes.Internal, "failed to list sessions")
+	}
+	sessionResponses := make([]*v1.SessionResponse, 0, len(sessions))
+	for i := range sessions {
+		sessionResponses = append(sessionResponses, toSessionResponse(&sessions[i]))
+	}
+	return &v1.ListSessionsResponse{
+		Offset:       req.Offset,
+		RowCount:     int64(len(sessions)),
+		TotalResults: total,
+		Sessions:     sessionResponses,
+	}, nil
+}
+
+func (srvc *sessionService) UpdateSession(ctx context.Context, req *v1.UpdateSessionRequest) (*v1.UpdateSessionResponse, error) {
+	session, err := srvc.findOwnedSession(ctx, req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	update := domain.SessionUpdate{Name: req.Name}
+	if req.ReservePrice != nil {
+		reservePrice, err := toDecimal("reserve_price", req.ReservePrice)
+		if err != nil {
+			return nil, err
+		}
+		update.ReservePrice = &reservePrice
+	}
+	if req.EndTime != nil {
+		endTime := req.EndTime.AsTime()
+		update.EndTime = &endTime
+	}
+	if err := session.Update(update, time.Now()); err != nil {
+		if ok, st := validationStatus("invalid session update", err); ok {
+			return nil, st
+		}
+		return nil, status.Errorf(codes.FailedPrecondition, "session can't be updated: %s", err)
+	}
+
+	updated, err := srvc.sessionRepo.Update(ctx, session)
+	if err != nil {
+		srvc.log.Error("failed to update session", "sessionId", session.Id, "err", err)
+		return nil, status.Errorf(codes.Internal, "failed to update session")
+	}
+	if !updated {
+		return nil, status.Errorf(codes.FailedPrecondition, "session %s is no longer scheduled", session.Id)
+	}
+	srvc.log.Info("updated session", "sessionId", session.Id)
+	return &v1.UpdateSessionResponse{
+		Session: toSessionResponse(session),
+	}, nil
+}
+
+func (srvc *sessionService) CancelSession(ctx context.Context, req *v1.CancelSessionRequest) (*v1.CancelSessionResponse, error) {
+	session, err := srvc.findOwnedSession(ctx, req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	cancelled, err := srvc.lifecycle.Transition(ctx, session, domain.CancelledSession, time.Now())
+	if err != nil {
+		if !domain.CanTransition(session.Status, domain.CancelledSession) {
+			return nil, status.Errorf(codes.FailedPrecondition, "session %s is %s and can't be cancelled", session.Id, session.Status)
+		}
+		srvc.log.Error("failed to cancel session", "sessionId", session.Id, "err", err)
+		return nil, status.Errorf(codes.Internal, "failed to cancel session")
+	}
+	if !cancelled {
+		return nil, status.Errorf(codes.FailedPrecondition, "session %s changed status meanwhile, retry", session.Id)
+	}
+	return &v1.CancelSessionResponse{
+		Session: toSessionResponse(session),
+	}, nil
+}
+
+func (srvc *sessionService) findSession(ctx context.Context, sessionId string) (*domain.Session, error) {
+	if sessionId == "" {
+		return nil, invalidField("session_id", "sessionId is required")
+	}
+	session, err := srvc.sessionRepo.FindById(ctx, sessionId)
+	if err != nil {
+		if errors.Is(err, postgres.ErrSessionNotFound) {
+			return nil, status.Errorf(codes.NotFound, "session %s not found", sessionId)
+		}
+		srvc.log.Error("failed to find session", "sessionId", sessionId, "err", err)
+		return nil, status.Errorf(codes.Internal, "failed to find session")
+	}
+	return session, nil
+}
+
+// findOwnedSession returns the session only when the caller owns it. CreateSession records the caller as the owner,
+// the sessions created before it did have none and can't be managed.
+func (srvc *sessionService) findOwnedSession(ctx context.Context, sessionId string) (*domain.Session, error) {
+	userFp, err := userFpFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	session, err := srvc.findSession(ctx, sessionId)
+	if err != nil {
+		return nil, err
+	}
+	if session.UserFp == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "session %s has no recorded owner, it can't be managed", sessionId)
+	}
+	if session.UserFp != userFp {
+		return nil, status.Errorf(codes.PermissionDenied, "only the owner of session %s can manage it", sessionId)
+	}
+	return session, nil
+}
+
+func (srvc *sessionService) GetSessionSettlement(ctx context.Context, req *v1.GetSessionSettlementRequest) (*v1.GetSessionSettlementResponse, error) {
+	if req.SessionId == "" {
+		return nil, invalidField("session_id", "sessionId is required")
+	}
+	settlement, err := srvc.settlementRepo.FindBySessionId(ctx, req.SessionId)
+	if err != nil {
+		srvc.log.Error("failed to find session settlement", "sessionId", req.SessionId, "err", err)
+		return nil, status.Errorf(codes.Internal, "failed to find session settlement")
+	}
+	if settlement == nil {
+		return nil, status.Errorf(codes.NotFound, "no settlement found for sessionId=%s", req.SessionId)
+	}
+	return &v1.GetSessionSettlementResponse{
+		Settlement: toSettlementResponse(settlement),
+	}, nil
+}
+
+func (srvc *sessionService) ConfirmSettlement(ctx context.Context, req *v1.ConfirmSettlementRequest) (*v1.ConfirmSettlementResponse, error) {
+	userFp, err := userFpFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.SessionId == "" {
+		return nil, invalidField("session_id", "sessionId is required")
+	}
+
+	settlement, err := srvc.settlement.Confirm(ctx, req.SessionId, userFp, time.Now())
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrSettlementNotFound):
+			return nil, status.Errorf(codes.NotFound, "no settlement found for sessionId=%s", req.SessionId)
+		case errors.Is(err, service.ErrNotSessionSeller):
+			return nil, status.Errorf(codes.PermissionDenied, "only the seller of the session can confirm its settlement")
+		}
+		srvc.log.Error("failed to confirm session settlement", "sessionId", req.SessionId, "err", err)
+		return nil, status.Errorf(codes.FailedPrecondition, "settlement can't be confirmed: %v", err)
+	}
+	return &v1.ConfirmSettlementResponse{
+		Settlement: toSettlementResponse(settlement),
+	}, nil
+}
+
+func toSettlementResponse(settlement *domain.Settlement) *v1.SettlementResponse {
+	response := &v1.SettlementResponse{
+		SettlementId: settlement.Id,
+		SessionId:    settlement.SessionId,
+		AssetId:      settlement.AssetId,
+		AuctionType:  settlement.AuctionType,
+		Status:       settlement.Status,
+		ReserveMet:   settlement.ReserveMet,
+		ReservePrice: toMoney(settlement.ReservePrice),
+		SettledAt:    timestamppb.New(settlement.SettledAt),
+	}
+	if settlement.ExecutedAt != nil {
+		response.ExecutedAt = timestamppb.New(*settlement.ExecutedAt)
+	}
+	for _, award := range settlement.Awards {
+		response.Awards = append(response.Awards, &v1.SettlementAward{
+			BidId:         award.BidId,
+			WinnerFp:      award.UserFp,
+			BidAmount:     toMoney(award.BidAmount),
+			ClearingPrice: toMoney(award.Price),
+			Quantity:      float32(award.Quantity),
+		})
+	}
+	return response
+}
+
+func toSessionResponse(session *domain.Session) *v1.SessionResponse {
+	return &v1.SessionResponse{
+		SessionId:           session.Id,
+		Name:                &session.Name,
+		Status:              session.Status,
+		UserFp:              session.UserFp,
+		AssetId:             session.AssetId,
+		AuctionType:         session.ActionType,
+		AutoExecute:         session.AutoExecute,
+		ReservePrice:        toMoney(session.ReservePrice),
+		EndTime:             timestamppb.New(session.EndTime),
+		StartTime:           timestamppb.New(session.StartTime),
+		CreatedAt:           timestamppb.New(session.CreatedAt),
+		CurrentHighestBid:   toMoney(session.CurrentHighestBid),
+		BidIncrementAmount:  toMoney(session.BidIncrementAmount),
+		StartingPrice:       toMoney(session.StartingPrice),
+		PriceStep:           toMoney(session.PriceStep),
+		PriceStepSeconds:    session.PriceStepSeconds,
+		RevealSeconds:       session.RevealSeconds,
+		UnitPrice:           toMoney(session.UnitPrice),
+		AvailableQuantity:   float32(session.AvailableQuantity),
+		PricingRule:         session.PricingRule,
+		SoftCloseSeconds:    session.SoftCloseSeconds,
+		ExtensionSeconds:    session.ExtensionSeconds,
+		MaxExtensionSeconds: session.MaxExtensionSeconds,
+		ExtendedSeconds:     session.ExtendedSeconds,
+	}
+}
+
+func NewSessionServiceServer(log slog.Logger, repos postgres.Repositories, sessions service.SessionServ,
+	lifecycle service.SessionLifecycle, settlement service.SessionSettlement) v1.SessionServiceServer {
 	return &sessionService{
-		log:         log,
-		sessionRepo: sessionRepo,
+		log:            log,
+		sessions:       sessions,
+		lifecycle:      lifecycle,
+		settlement:     settlement,
+		sessionRepo:    repos.SessionRepository,
+		settlementRepo: repos.SettlementRepository,
 	}
 }
@@ -0,0 +1,18 @@
+package services
+
+import (
+	"context"
+	"xrf197ilz35aq2/server/grpc/interceptor"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// userFpFromContext returns the caller's user fingerprint, authenticated by the interceptor chain.
+func userFpFromContext(ctx context.Context) (string, error) {
+	userFp, ok := interceptor.UserFp(ctx)
+	if !ok {
+		return "", status.Errorf(codes.Unauthenticated, "missing user fingerprint")
+	}
+	return userFp, nil
+}
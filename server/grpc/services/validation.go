@@ -0,0 +1,56 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"xrf197ilz35aq2/core/domain"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// invalidRequest is a codes.InvalidArgument error carrying an errdetails.BadRequest with a violation per invalid field,
+// so clients can point at the exact fields to fix.
+func invalidRequest(message string, fields ...domain.FieldError) error {
+	badRequest := &errdetails.BadRequest{FieldViolations: make([]*errdetails.BadRequest_FieldViolation, 0, len(fields))}
+	for _, field := range fields {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field.Field,
+			Description: field.Description,
+		})
+	}
+	st, err := status.New(codes.InvalidArgument, message).WithDetails(badRequest)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%s: %v", message, fields)
+	}
+	return st.Err()
+}
+
+// invalidField is invalidRequest for a single invalid field.
+func invalidField(field string, format string, args ...any) error {
+	description := fmt.Sprintf(format, args...)
+	return invalidRequest(description, domain.FieldError{Field: field, Description: description})
+}
+
+// validationStatus maps the domain's validation errors to invalidRequest, false for any other error.
+func validationStatus(message string, err error) (bool, error) {
+	var validationErr *domain.ValidationError
+	if errors.As(err, &validationErr) {
+		return true, invalidRequest(message, validationErr.Fields...)
+	}
+	var fieldErr *domain.FieldError
+	if errors.As(err, &fieldErr) {
+		return true, invalidRequest(fmt.Sprintf("%s: %s", message, fieldErr.Description), *fieldErr)
+	}
+	return false, nil
+}
+
+// invalidArgument is validationStatus for errors that are all caused by the request, other errors are a plain
+// codes.InvalidArgument.
+func invalidArgument(message string, err error) error {
+	if ok, st := validationStatus(message, err); ok {
+		return st
+	}
+	return status.Errorf(codes.InvalidArgument, "%s: %s", message, err)
+}
@@ -0,0 +1,160 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Dependencies probed by the checker.
+const (
+	Postgres    = "postgres"
+	TimescaleDB = "timescaledb"
+	Redis       = "redis"
+)
+
+const probeTimeout = 2 * time.Second
+
+// Probe checks a dependency is reachable, e.g., storage.Postgres.Ping.
+type Probe func(ctx context.Context) error
+
+// Checker probes the dependencies of the app and reports their state through the grpc.health.v1 service, a gRPC
+// service is NOT_SERVING while one of its dependencies fails. The overall server status ("") needs every dependency.
+type Checker struct {
+	log      slog.Logger
+	server   *health.Server
+	probes   map[string]Probe
+	services map[string][]string // the dependencies of every gRPC service, by service name
+	tick     time.Duration
+
+	mu      sync.Mutex
+	healthy map[string]bool // last probe result by dependency
+}
+
+func NewChecker(log slog.Logger, server *health.Server, probes map[string]Probe, services map[string][]string, tick time.Duration) *Checker {
+	return &Checker{
+		log:      log,
+		server:   server,
+		probes:   probes,
+		services: services,
+		tick:     tick,
+		healthy:  make(map[string]bool, len(probes)),
+	}
+}
+
+// Run probes the dependencies every tick until the context is canceled.
+func (checker *Checker) Run(ctx context.Context) error {
+	checker.Check(ctx)
+	ticker := time.NewTicker(checker.tick)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			checker.log.Info("** health checker shutting down **")
+			checker.server.Shutdown()
+			return ctx.Err()
+		case <-ticker.C:
+			checker.Check(ctx)
+		}
+	}
+}
+
+// Check probes every dependency, updates the served statuses and returns the failure of every unhealthy dependency.
+func (checker *Checker) Check(ctx context.Context) map[string]error {
+	failures := make(map[string]error)
+	var failuresMu sync.Mutex
+	var wg sync.WaitGroup
+	for name, probe := range checker.probes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			probeCtx, cancel := context.WithTimeout(ctx, probeTimeout)
+			defer cancel()
+			if err := probe(probeCtx); err != nil {
+				failuresMu.Lock()
+				failures[name] = err
+				failuresMu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	checker.update(failures)
+	return failures
+}
+
+func (checker *Checker) update(failures map[string]error) {
+	checker.mu.Lock()
+	defer checker.mu.Unlock()
+
+	for name := range checker.probes {
+		err, failed := failures[name]
+		if wasHealthy, probed := checker.healthy[name]; !probed || wasHealthy == failed {
+			if failed {
+				checker.log.Error("dependency unhealthy", "dependency", name, "err", err)
+			} else {
+				checker.log.Info("dependency healthy", "dependency", name)
+			}
+		}
+		checker.healthy[name] = !failed
+	}
+
+	for service, dependencies := range checker.services {
+		checker.server.SetServingStatus(service, servingStatus(failures, dependencies...))
+	}
+	checker.server.SetServingStatus("", servingStatus(failures))
+}
+
+// servingStatus is SERVING when none of the dependencies failed, every dependency when none is given.
+func servingStatus(failures map[string]error, dependencies ...string) healthpb.HealthCheckResponse_ServingStatus {
+	if len(dependencies) == 0 && len(failures) > 0 {
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	for _, dependency := range dependencies {
+		if _, failed := failures[dependency]; failed {
+			return healthpb.HealthCheckResponse_NOT_SERVING
+		}
+	}
+	return healthpb.HealthCheckResponse_SERVING
+}
+
+type readiness struct {
+	Status       string            `json:"status"`
+	Dependencies map[string]string `json:"dependencies"`
+}
+
+// Liveness serves /healthz, the process is up and serving HTTP.
+func (checker *Checker) Liveness(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(`{"status":"ok"}`))
+}
+
+// Readiness serves /readyz with the last result of the periodic Check, it answers 503 when a dependency failed or
+// wasn't probed yet. The dependencies aren't probed per request, polling the endpoint doesn't load them.
+func (checker *Checker) Readiness(w http.ResponseWriter, _ *http.Request) {
+	ready := readiness{Status: "ok", Dependencies: make(map[string]string, len(checker.probes))}
+	checker.mu.Lock()
+	for name := range checker.probes {
+		ready.Dependencies[name] = "ok"
+		// the failure itself is logged, not exposed
+		if !checker.healthy[name] {
+			ready.Dependencies[name] = "unavailable"
+			ready.Status = "unavailable"
+		}
+	}
+	checker.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if ready.Status != "ok" {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if err := json.NewEncoder(w).Encode(ready); err != nil {
+		checker.log.Error("failed to write readiness response", "err", err)
+	}
+}
@@ -0,0 +1,45 @@
+package identity
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+// AuthorizationHeader carries the caller's credential, "Bearer <token>", a token issued by the account service and
+// verified with the TokenSigner sharing its secret. The user fingerprint is only ever read from a verified token.
+const AuthorizationHeader = "authorization"
+
+const bearerPrefix = "Bearer "
+
+const maxUserFpLength = 128
+
+// ValidateUserFp checks the user fingerprint carried by a token.
+func ValidateUserFp(userFp string) error {
+	if userFp == "" {
+		return errors.New("user fingerprint is empty")
+	}
+	if len(userFp) > maxUserFpLength || strings.IndexFunc(userFp, invalidFpRune) >= 0 {
+		return errors.New("malformed user fingerprint")
+	}
+	return nil
+}
+
+func invalidFpRune(r rune) bool {
+	return unicode.IsSpace(r) || unicode.IsControl(r)
+}
+
+// BearerToken reads the token from the values of AuthorizationHeader, the header must be sent exactly once.
+func BearerToken(values []string) (string, error) {
+	if len(values) == 0 {
+		return "", errors.New("missing authorization header")
+	}
+	if len(values) > 1 {
+		return "", errors.New("authorization header sent more than once")
+	}
+	token, ok := strings.CutPrefix(values[0], bearerPrefix)
+	if !ok || token == "" {
+		return "", errors.New("authorization header is not a bearer token")
+	}
+	return token, nil
+}
@@ -0,0 +1,101 @@
+package identity
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const tokenSep = "."
+
+// TokenSigner issues and verifies tokens carrying a user fingerprint, signed with HMAC-SHA256. Callers authenticate with
+// a token the account service signed with the secret it shares with this service, and browsers open websockets with a
+// short-lived token signed with a secret of this service only. A token is "<fingerprint>.<expiry>.<signature>", the
+// fingerprint base64url encoded and the expiry in unix seconds.
+type TokenSigner interface {
+	TokenVerifier
+	Sign(userFp string, now time.Time) (string, time.Time)
+}
+
+// TokenVerifier verifies the tokens issued by a TokenSigner sharing its secret.
+type TokenVerifier interface {
+	Verify(token string, now time.Time) (string, error)
+}
+
+type tokenSigner struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+// Sign issues a token for the user fingerprint, it returns the token and when it expires.
+func (signer *tokenSigner) Sign(userFp string, now time.Time) (string, time.Time) {
+	expiresAt := now.Add(signer.ttl).Truncate(time.Second)
+	payload := base64.RawURLEncoding.EncodeToString([]byte(userFp)) + tokenSep + strconv.FormatInt(expiresAt.Unix(), 10)
+	return payload + tokenSep + signer.signature(payload), expiresAt
+}
+
+// Verify returns the user fingerprint of a token issued by Sign, as long as it didn't expire.
+func (signer *tokenSigner) Verify(token string, now time.Time) (string, error) {
+	parts := strings.Split(token, tokenSep)
+	if len(parts) != 3 {
+		return "", errors.New("malformed token")
+	}
+	payload := parts[0] + tokenSep + parts[1]
+	if !hmac.Equal([]byte(parts[2]), []byte(signer.signature(payload))) {
+		return "", errors.New("invalid token signature")
+	}
+	expiresAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", errors.New("malformed token")
+	}
+	if !now.Before(time.Unix(expiresAt, 0)) {
+		return "", errors.New("token expired")
+	}
+	userFp, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return "", errors.New("malformed token")
+	}
+	if err := ValidateUserFp(string(userFp)); err != nil {
+		return "", err
+	}
+	return string(userFp), nil
+}
+
+func (signer *tokenSigner) signature(payload string) string {
+	mac := hmac.New(sha256.New, signer.secret)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// minSecretLength is the shortest secret tokens are signed with, as long as the SHA-256 output.
+const minSecretLength = sha256.Size
+
+func NewTokenSigner(secret string, ttl time.Duration) (TokenSigner, error) {
+	if err := validateSecret(secret); err != nil {
+		return nil, err
+	}
+	if ttl <= 0 {
+		return nil, fmt.Errorf("token ttl must be positive, got %s", ttl)
+	}
+	return &tokenSigner{secret: []byte(secret), ttl: ttl}, nil
+}
+
+// NewTokenVerifier verifies the tokens signed with the secret by another service, e.g., the account service's.
+func NewTokenVerifier(secret string) (TokenVerifier, error) {
+	if err := validateSecret(secret); err != nil {
+		return nil, err
+	}
+	return &tokenSigner{secret: []byte(secret)}, nil
+}
+
+func validateSecret(secret string) error {
+	if len(secret) < minSecretLength {
+		return fmt.Errorf("token secret must be at least %d bytes long", minSecretLength)
+	}
+	return nil
+}
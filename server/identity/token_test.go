@@ -0,0 +1,116 @@
+package identity
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	testSecret  = "0123456789abcdef0123456789abcdef"
+	otherSecret = "fedcba9876543210fedcba9876543210"
+)
+
+func TestTokenVerify(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	signer, err := NewTokenSigner(testSecret, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, expiresAt := signer.Sign("user-1", now)
+	if want := now.Add(time.Minute); !expiresAt.Equal(want) {
+		t.Fatalf("expires at = %s, want %s", expiresAt, want)
+	}
+	other, err := NewTokenSigner(otherSecret, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherToken, _ := other.Sign("user-1", now)
+	emptyFpToken, _ := signer.Sign("", now)
+	parts := strings.Split(token, tokenSep)
+
+	tests := []struct {
+		name    string
+		token   string
+		at      time.Time
+		wantErr bool
+	}{
+		{name: "valid", token: token, at: now},
+		{name: "valid just before expiry", token: token, at: expiresAt.Add(-time.Nanosecond)},
+		{name: "expired at the expiry", token: token, at: expiresAt, wantErr: true},
+		{name: "expired", token: token, at: expiresAt.Add(time.Hour), wantErr: true},
+		{name: "signed with another secret", token: otherToken, at: now, wantErr: true},
+		{name: "tampered fingerprint", token: "dXNlci0y" + tokenSep + parts[1] + tokenSep + parts[2], at: now, wantErr: true},
+		{name: "tampered expiry", token: parts[0] + tokenSep + "99999999999" + tokenSep + parts[2], at: now, wantErr: true},
+		{name: "missing signature", token: parts[0] + tokenSep + parts[1], at: now, wantErr: true},
+		{name: "extra part", token: token + tokenSep + "x", at: now, wantErr: true},
+		{name: "empty", token: "", at: now, wantErr: true},
+		{name: "empty fingerprint", token: emptyFpToken, at: now, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			userFp, err := signer.Verify(test.token, test.at)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("verified %q as %s, want an error", test.token, userFp)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("verify failed: %v", err)
+			}
+			if userFp != "user-1" {
+				t.Errorf("user fingerprint = %s, want user-1", userFp)
+			}
+		})
+	}
+}
+
+func TestTokenVerifierSharesTheSignerSecret(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	signer, err := NewTokenSigner(testSecret, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, _ := signer.Sign("user-1", now)
+	tests := []struct {
+		name    string
+		secret  string
+		wantErr bool
+	}{
+		{name: "same secret", secret: testSecret},
+		{name: "other secret", secret: otherSecret, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			verifier, err := NewTokenVerifier(test.secret)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := verifier.Verify(token, now); (err != nil) != test.wantErr {
+				t.Errorf("verify err = %v, want error %t", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewTokenSigner(t *testing.T) {
+	tests := []struct {
+		name    string
+		secret  string
+		ttl     time.Duration
+		wantErr bool
+	}{
+		{name: "valid", secret: testSecret, ttl: time.Minute},
+		{name: "short secret", secret: testSecret[1:], ttl: time.Minute, wantErr: true},
+		{name: "zero ttl", secret: testSecret, ttl: 0, wantErr: true},
+		{name: "negative ttl", secret: testSecret, ttl: -time.Minute, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := NewTokenSigner(test.secret, test.ttl); (err != nil) != test.wantErr {
+				t.Errorf("err = %v, want error %t", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package socket
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+	"xrf197ilz35aq2/server/identity"
+
+	"github.com/gorilla/websocket"
+)
+
+// tokenQueryParam carries a token issued by ServeToken, browsers can't set headers on the websocket handshake.
+const tokenQueryParam = "token"
+
+// Authenticator admits websocket connections before they are upgraded. The request must come from an allowed origin
+// and authenticate its user, either with a credential as gRPC calls do (an identity.AuthorizationHeader bearer token
+// issued by the account service), or with a token issued by ServeToken sent as a bearer token or in the token query
+// parameter.
+type Authenticator struct {
+	verifier  identity.TokenVerifier // the callers' credentials
+	signer    identity.TokenSigner   // the websocket tokens
+	origins   map[string]bool
+	anyOrigin bool
+	// The upgrader upgrades an HTTP connection to a WebSocket connection.
+	upgrader websocket.Upgrader
+}
+
+// TokenResponse is the body of a ServeToken response.
+type TokenResponse struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// ServeToken issues a short-lived token opening websocket connections to the user authenticated by the request's
+// credential, for browsers that can't send it on the websocket handshake.
+func (auth *Authenticator) ServeToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	token, err := identity.BearerToken(r.Header.Values(identity.AuthorizationHeader))
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="websocket"`)
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	userFp, err := auth.verifier.Verify(token, time.Now())
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="websocket", error="invalid_token"`)
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	wsToken, expiresAt := auth.signer.Sign(userFp, time.Now())
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	_ = json.NewEncoder(w).Encode(TokenResponse{Token: wsToken, ExpiresAt: expiresAt})
+}
+
+// authenticate returns the fingerprint of the user opening the websocket. A bearer token is a websocket token or a
+// credential, the token query parameter only ever carries a websocket token.
+func (auth *Authenticator) authenticate(r *http.Request) (string, error) {
+	now := time.Now()
+	if values := r.Header.Values(identity.AuthorizationHeader); len(values) > 0 {
+		token, err := identity.BearerToken(values)
+		if err != nil {
+			return "", err
+		}
+		if userFp, err := auth.signer.Verify(token, now); err == nil {
+			return userFp, nil
+		}
+		return auth.verifier.Verify(token, now)
+	}
+	token := r.URL.Query().Get(tokenQueryParam)
+	if token == "" {
+		return "", errors.New("missing bearer token or token query parameter")
+	}
+	return auth.signer.Verify(token, now)
+}
+
+// checkOrigin accepts requests without an Origin header, those don't come from a browser.
+func (auth *Authenticator) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	return origin == "" || auth.anyOrigin || auth.origins[normalizeOrigin(origin)]
+}
+
+func normalizeOrigin(origin string) string {
+	return strings.TrimSuffix(strings.ToLower(origin), "/")
+}
+
+// NewAuthenticator admits connections from the allowedOrigins, e.g. https://bids.example.com, "*" allows any origin.
+// verifier verifies the callers' credentials and signer the websocket tokens, their secrets must differ.
+func NewAuthenticator(verifier identity.TokenVerifier, signer identity.TokenSigner, allowedOrigins []string) *Authenticator {
+	auth := &Authenticator{verifier: verifier, signer: signer, origins: make(map[string]bool, len(allowedOrigins))}
+	for _, origin := range allowedOrigins {
+		if origin == "*" {
+			auth.anyOrigin = true
+		}
+		auth.origins[normalizeOrigin(origin)] = true
+	}
+	auth.upgrader = websocket.Upgrader{
+		// ReadBufferSize and WriteBufferSize specify the I/O buffer size.
+		ReadBufferSize:  1024,
+		WriteBufferSize: 1024,
+		// CheckOrigin returns true if the request Origin header is acceptable.
+		CheckOrigin: auth.checkOrigin,
+	}
+	return auth
+}
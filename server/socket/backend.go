@@ -0,0 +1,187 @@
+package socket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	MemoryBackend = "memory"
+	RedisBackend  = "redis"
+
+	// broadcastChannel is the redis channel every instance publishes its messages to and subscribes to.
+	broadcastChannel = "socket_broadcast_channel"
+	// sequenceRetention is how long the sequence of a topic is kept in redis after its latest message, a topic quiet for
+	// longer starts over from 1 and its subscribers resync.
+	sequenceRetention = 7 * 24 * time.Hour
+)
+
+// publishScript numbers the message in each of its topics and publishes it in one step, so that messages are received
+// in the order of their sequences. KEYS are the sequence keys of the message's topics, ARGV the channel, the message and
+// the retention of the sequences in seconds.
+var publishScript = redis.NewScript(`
+local message = cjson.decode(ARGV[2])
+local sequences = {}
+for i, key in ipairs(KEYS) do
+	sequences[i] = redis.call('INCR', key)
+	redis.call('EXPIRE', key, ARGV[3])
+end
+message['sequences'] = sequences
+return redis.call('PUBLISH', ARGV[1], cjson.encode(message))
+`)
+
+// Backend carries the messages published on any instance to the hub of every instance, which delivers them to its own
+// clients. Publish numbers the message in each of its topics (Message.Sequences), every instance receives the messages
+// of a topic in the order of their sequences.
+type Backend interface {
+	Publish(ctx context.Context, message Message) error
+	// Subscribe returns the messages published from now on, the channel is closed once ctx is done.
+	Subscribe(ctx context.Context) (<-chan Message, error)
+}
+
+// memoryBackend only reaches the clients of this instance, for a single node. The sequence of a topic is dropped along
+// with its replay buffer, replayRetention after its latest message, and starts over from 1; its subscribers resync.
+type memoryBackend struct {
+	mutex     sync.Mutex
+	sequences map[string]topicSequence
+	sweptAt   time.Time
+	messages  chan Message
+}
+
+// topicSequence is the latest sequence of a topic, and when it was assigned.
+type topicSequence struct {
+	latest uint64
+	at     time.Time
+}
+
+func (backend *memoryBackend) Publish(ctx context.Context, message Message) error {
+	// the lock is held until the message is handed to the hub, so messages are received in the order of their sequences
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	now := time.Now()
+	backend.sweep(now)
+	message.Sequences = make([]uint64, len(message.Topics))
+	for i, topic := range message.Topics {
+		message.Sequences[i] = backend.sequences[topic].latest + 1
+	}
+	select {
+	case backend.messages <- message:
+		for i, topic := range message.Topics {
+			backend.sequences[topic] = topicSequence{latest: message.Sequences[i], at: now}
+		}
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// sweep drops the sequences of the topics without a message for replayRetention, as the hub drops their replay buffers.
+func (backend *memoryBackend) sweep(now time.Time) {
+	if now.Sub(backend.sweptAt) < replayRetention/10 {
+		return
+	}
+	backend.sweptAt = now
+	for topic, sequence := range backend.sequences {
+		if now.Sub(sequence.at) > replayRetention {
+			delete(backend.sequences, topic)
+		}
+	}
+}
+
+func (backend *memoryBackend) Subscribe(ctx context.Context) (<-chan Message, error) {
+	return backend.messages, nil
+}
+
+func NewMemoryBackend() Backend {
+	return &memoryBackend{sequences: make(map[string]topicSequence), messages: make(chan Message)}
+}
+
+// redisBackend fans the messages out to every instance with redis pub/sub.
+type redisBackend struct {
+	log    slog.Logger
+	client *redis.Client
+}
+
+func (backend *redisBackend) Publish(ctx context.Context, message Message) error {
+	messageJSON, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("marshaling broadcast message failed with err=%w", err)
+	}
+	keys := make([]string, 0, len(message.Topics))
+	for _, topic := range message.Topics {
+		keys = append(keys, sequenceKey(topic))
+	}
+	err = publishScript.Run(ctx, backend.client, keys, broadcastChannel, messageJSON, int64(sequenceRetention.Seconds())).Err()
+	if err != nil {
+		return fmt.Errorf("publishing broadcast message failed with err=%w", err)
+	}
+	return nil
+}
+
+func (backend *redisBackend) Subscribe(ctx context.Context) (<-chan Message, error) {
+	pubSub := backend.client.Subscribe(ctx, broadcastChannel)
+	// wait for the subscription to be confirmed, messages published after it returns are received
+	if _, err := pubSub.Receive(ctx); err != nil {
+		_ = pubSub.Close()
+		return nil, fmt.Errorf("subscribing to broadcast messages failed with err=%w", err)
+	}
+
+	messages := make(chan Message)
+	go func() {
+		defer close(messages)
+		defer func() {
+			_ = pubSub.Close()
+		}()
+		// the channel of the PubSub reconnects to redis on its own
+		published := pubSub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case payload, ok := <-published:
+				if !ok {
+					return
+				}
+				var message Message
+				if err := json.Unmarshal([]byte(payload.Payload), &message); err != nil {
+					backend.log.Error("failed to unmarshal broadcast message", "err", err)
+					continue
+				}
+				select {
+				case messages <- message:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return messages, nil
+}
+
+// sequenceKey is the key of the topic's sequence. The keys of every topic share the {socket_topic_sequence} hash tag, so
+// they are in the same redis cluster slot and publishScript numbers a message in all its topics at once.
+func sequenceKey(topic string) string {
+	return fmt.Sprintf("{socket_topic_sequence}_%s", topic)
+}
+
+func NewRedisBackend(log slog.Logger, client *redis.Client) Backend {
+	return &redisBackend{log: log, client: client}
+}
+
+// NewBackend is the backend of the kind, MemoryBackend or RedisBackend, the memory backend when kind is unset.
+func NewBackend(kind string, log slog.Logger, client *redis.Client) (Backend, error) {
+	switch kind {
+	case "", MemoryBackend:
+		return NewMemoryBackend(), nil
+	case RedisBackend:
+		return NewRedisBackend(log, client), nil
+	default:
+		return nil, fmt.Errorf("unknown websocket broadcast backend %q, expected %s or %s", kind, MemoryBackend, RedisBackend)
+	}
+}
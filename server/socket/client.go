@@ -2,7 +2,6 @@ package socket
 
 import (
 	"log/slog"
-	"net/http"
 	"time"
 
 	"github.com/google/uuid"
@@ -23,39 +22,35 @@ const (
 	maxMessageSize = 512
 )
 
-// The upgrader upgrades an HTTP connection to a WebSocket connection.
-var upgrader = websocket.Upgrader{
-	// ReadBufferSize and WriteBufferSize specify the I/O buffer size.
-	ReadBufferSize:  1024,
-	WriteBufferSize: 1024,
-	// CheckOrigin returns true if the request Origin header is acceptable.
-	// For production, implement a proper check here.
-	// For DEV, we'll allow all connections.
-	CheckOrigin: func(r *http.Request) bool {
-		return true
-	},
-}
-
 // Client is an intermediary between the websocket connection and the hub.
 type Client struct {
-	hub  *Hub
-	id   string
-	send chan []byte
-	log  slog.Logger
-	conn *websocket.Conn
+	hub    *Hub
+	id     string
+	userFp string // the authenticated user, see Authenticator
+	outbox *outbox
+	log    slog.Logger
+	conn   *websocket.Conn
+	// dropped is how many messages the hub dropped for the client as a slow consumer, only the hub updates it
+	dropped uint64
 }
 
-func NewClient(hub *Hub, conn *websocket.Conn, log slog.Logger) *Client {
+func NewClient(hub *Hub, conn *websocket.Conn, userFp string, log slog.Logger) *Client {
 	return &Client{
-		hub:  hub,
-		conn: conn,
-		log:  log,
-		id:   uuid.New().String(),
-		send: make(chan []byte, 256),
+		hub:    hub,
+		conn:   conn,
+		log:    log,
+		userFp: userFp,
+		id:     uuid.New().String(),
+		outbox: newOutbox(),
 	}
 }
 
-// readPump pumps messages from the websocket connection to the hub.
+// UserFp is the fingerprint of the user the client was authenticated as.
+func (c *Client) UserFp() string {
+	return c.userFp
+}
+
+// readPump pumps messages from the websocket connection to the hub. Clients only send subscription requests.
 func (c *Client) readPump() {
 	defer func() {
 		c.hub.unregister <- c
@@ -80,7 +75,8 @@ func (c *Client) readPump() {
 			}
 			break
 		}
-		c.log.Info("client sent a message", "message", string(message), "id", c.id)
+		c.log.Debug("client sent a message", "message", string(message), "id", c.id)
+		c.hub.subscriptions <- parseSubscription(c, message)
 	}
 }
 
@@ -93,36 +89,26 @@ func (c *Client) writePump() {
 	}()
 	for {
 		select {
-		case message, ok := <-c.send:
-			err := c.conn.SetWriteDeadline(time.Now().Add(writeWait))
-			if err != nil {
-				return
-			}
-			if !ok {
-				// The hub closed the channel.
-				err = c.conn.WriteMessage(websocket.CloseMessage, []byte{})
-				if err != nil {
-					c.log.Error("write error", "err", err)
+		case <-c.outbox.ready:
+			for {
+				messages, closed := c.outbox.pop()
+				if closed {
+					// The hub closed the outbox.
+					_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+					err := c.conn.WriteMessage(websocket.CloseMessage, []byte{})
+					if err != nil {
+						c.log.Error("write error", "err", err)
+					}
 					return
 				}
-				return
-			}
-			c.log.Debug("write message", "message", string(message))
-
-			w, err := c.conn.NextWriter(websocket.TextMessage)
-			if err != nil {
-				c.log.Error("next writer error", "err", err)
-				return
-			}
-			_, err = w.Write(message)
-			if err != nil {
-				c.log.Error("write error", "err", err)
-				return
-			}
-
-			if err := w.Close(); err != nil {
-				c.log.Error("write error", "err", err)
-				return
+				if len(messages) == 0 {
+					break
+				}
+				for _, message := range messages {
+					if err := c.write(message.data); err != nil {
+						return
+					}
+				}
 			}
 		case <-ticker.C:
 			_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
@@ -133,3 +119,28 @@ func (c *Client) writePump() {
 		}
 	}
 }
+
+func (c *Client) write(message []byte) error {
+	err := c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+	if err != nil {
+		return err
+	}
+	c.log.Debug("write message", "message", string(message))
+
+	w, err := c.conn.NextWriter(websocket.TextMessage)
+	if err != nil {
+		c.log.Error("next writer error", "err", err)
+		return err
+	}
+	_, err = w.Write(message)
+	if err != nil {
+		c.log.Error("write error", "err", err)
+		return err
+	}
+
+	if err := w.Close(); err != nil {
+		c.log.Error("write error", "err", err)
+		return err
+	}
+	return nil
+}
@@ -5,14 +5,27 @@ import (
 	"net/http"
 )
 
-// ServeWS handles websocket requests from the peer.
-func ServeWS(hub *Hub, w http.ResponseWriter, r *http.Request, log slog.Logger) {
-	conn, err := upgrader.Upgrade(w, r, nil)
+// ServeWS handles websocket requests from the peer, only authenticated requests from allowed origins are upgraded.
+func ServeWS(hub *Hub, auth *Authenticator, w http.ResponseWriter, r *http.Request, log slog.Logger) {
+	if !auth.checkOrigin(r) {
+		log.Warn("rejected WS connection from origin", "origin", r.Header.Get("Origin"))
+		http.Error(w, "origin not allowed", http.StatusForbidden)
+		return
+	}
+	userFp, err := auth.authenticate(r)
+	if err != nil {
+		log.Warn("rejected unauthenticated WS connection", "err", err)
+		w.Header().Set("WWW-Authenticate", `Bearer realm="websocket"`)
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	conn, err := auth.upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		log.Error("failed to upgrade WS connection", "err", err)
 		return
 	}
-	client := NewClient(hub, conn, log)
+	client := NewClient(hub, conn, userFp, log)
 	client.hub.register <- client
 
 	go client.writePump()
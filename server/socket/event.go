@@ -0,0 +1,91 @@
+package socket
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// EventVersion is the version of the Event envelope, it changes when the envelope does.
+const EventVersion = 1
+
+// the types of the events pushed to the clients
+const (
+	BidPlacedEvent           = "bid.placed"
+	BidCancelledEvent        = "bid.cancelled"
+	PriceTickEvent           = "session.price_tick"
+	SessionExtendedEvent     = "session.extended"
+	SessionTransitionedEvent = "session.transitioned"
+	SettlementEvent          = "session.settlement" // settled, or executed once confirmed
+	// SubscriptionEvent answers a SubscriptionRequest, see SubscriptionReply
+	SubscriptionEvent = "subscription"
+)
+
+// isSnapshotEvent reports whether the events of the type carry the whole current state they are about, e.g., the clock
+// price, so the latest one supersedes the earlier ones. The other events are deltas, e.g., a placed bid, every one of
+// them counts.
+func isSnapshotEvent(eventType string) bool {
+	switch eventType {
+	case PriceTickEvent, SessionExtendedEvent, SettlementEvent:
+		return true
+	default:
+		return false
+	}
+}
+
+const (
+	// replayBufferSize is how many of the latest events of a topic are kept for the clients resuming a subscription, a
+	// replay must fit in the client's outbox.
+	replayBufferSize = 128
+	// replayRetention is how long the events of a topic are kept after its latest event.
+	replayRetention = 10 * time.Minute
+)
+
+// Event is the envelope of every message pushed to a client. Sequence increases by one with every event of the topic, a
+// client that notices a gap resumes its subscription from the last sequence it saw (see SubscriptionRequest).
+type Event struct {
+	Version   int             `json:"version"`
+	Type      string          `json:"type"`
+	Topic     string          `json:"topic"`
+	Sequence  uint64          `json:"sequence"`
+	Timestamp time.Time       `json:"timestamp"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+type bufferedEvent struct {
+	sequence uint64
+	data     []byte
+}
+
+// replayBuffer keeps the latest events of a topic, oldest first.
+type replayBuffer struct {
+	events      []bufferedEvent
+	lastEventAt time.Time
+}
+
+func (buffer *replayBuffer) add(sequence uint64, data []byte, at time.Time) {
+	buffer.events = append(buffer.events, bufferedEvent{sequence: sequence, data: data})
+	if len(buffer.events) > replayBufferSize {
+		buffer.events = buffer.events[len(buffer.events)-replayBufferSize:]
+	}
+	buffer.lastEventAt = at
+}
+
+// since returns the events after the sequence, complete is false when some of them are no longer kept (or the sequence
+// isn't one of the topic's), the client has to fetch the current state instead.
+func (buffer *replayBuffer) since(sequence uint64) ([][]byte, bool) {
+	if buffer == nil || len(buffer.events) == 0 {
+		return nil, false
+	}
+	oldest, latest := buffer.events[0].sequence, buffer.events[len(buffer.events)-1].sequence
+	if sequence > latest {
+		return nil, false
+	}
+	complete := sequence+1 >= oldest
+	missed := make([][]byte, 0, len(buffer.events))
+	for _, event := range buffer.events {
+		if event.sequence > sequence {
+			missed = append(missed, event.data)
+		}
+	}
+	return missed, complete
+}
@@ -2,57 +2,261 @@ package socket
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log/slog"
+	"sync/atomic"
+	"time"
 )
 
+// publishQueueSize is how many published messages wait to be handed to the Backend, more are dropped.
+const publishQueueSize = 1024
+
+// dropLogInterval logs every dropLogInterval-th message dropped for a client (or published) after the first one.
+const dropLogInterval = 100
+
 // Hub maintains the set of active clients and broadcasts messages to them.
 // The Hub is the central component that manages all connected clients and message broadcasting.
 // This approach encapsulates the concurrency logic for handling multiple clients.
+// Clients only get the messages of the topics (assets and sessions) they subscribed to, see SubscriptionRequest.
+// Messages are published through the Backend, so that the clients connected to any instance get them, and are pushed
+// to the clients as an Event of each topic. The latest events of every topic are kept to be replayed to the clients
+// resuming a subscription. Publishing never blocks and a slow client never holds the others back, see
+// SlowConsumerPolicy.
 type Hub struct {
-	Broadcast  chan []byte
-	register   chan *Client
-	unregister chan *Client
-	clients    map[*Client]bool
-	logger     slog.Logger
+	backend       Backend
+	policy        SlowConsumerPolicy
+	publishing    chan Message // the published messages waiting to be handed to the backend
+	dropped       atomic.Uint64
+	register      chan *Client
+	unregister    chan *Client
+	subscriptions chan subscription
+	clients       map[*Client]map[string]bool // the topics of each client
+	topics        map[string]map[*Client]bool // the clients of each topic
+	replay        map[string]*replayBuffer    // the latest events of each topic
+	logger        slog.Logger
 }
 
-func NewHub(logger slog.Logger) *Hub {
+func NewHub(logger slog.Logger, backend Backend, policy SlowConsumerPolicy) *Hub {
 	return &Hub{
-		logger:     logger,
-		Broadcast:  make(chan []byte),
-		register:   make(chan *Client),
-		unregister: make(chan *Client),
-		clients:    make(map[*Client]bool),
+		logger:        logger,
+		backend:       backend,
+		policy:        policy,
+		publishing:    make(chan Message, publishQueueSize),
+		register:      make(chan *Client),
+		unregister:    make(chan *Client),
+		subscriptions: make(chan subscription),
+		clients:       make(map[*Client]map[string]bool),
+		topics:        make(map[string]map[*Client]bool),
+		replay:        make(map[string]*replayBuffer),
+	}
+}
+
+// Publish broadcasts the message to the subscribers of its topics on every instance. It doesn't wait for the message to
+// be handed to the backend, a message that can't be published is dropped and logged: the websocket is a best effort
+// notification, it must not hold back the bid or session change it notifies.
+func (h *Hub) Publish(message Message) {
+	if len(message.Topics) == 0 {
+		return // nobody can be subscribed to it
+	}
+	message.Timestamp = time.Now()
+	select {
+	case h.publishing <- message:
+	default:
+		if dropped := h.dropped.Add(1); dropped == 1 || dropped%dropLogInterval == 0 {
+			h.logger.Warn("dropped websocket message, the publish queue is full", "type", message.Type,
+				"topics", message.Topics, "dropped", dropped)
+		}
+	}
+}
+
+// forward hands the published messages to the backend, in the order they were published.
+func (h *Hub) forward(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case message := <-h.publishing:
+			if err := h.backend.Publish(ctx, message); err != nil {
+				h.logger.Error("failed to publish websocket message", "topics", message.Topics, "err", err)
+			}
+		}
 	}
 }
 
 func (h *Hub) Run(ctx context.Context) error {
+	messages, err := h.backend.Subscribe(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to broadcast messages: %w", err)
+	}
+	go h.forward(ctx)
+	sweep := time.NewTicker(replayRetention / 10)
+	defer sweep.Stop()
 	for {
 		select {
 		case <-ctx.Done():
 			h.logger.Info("** WS hub shutting down **")
 			// Gracefully close all client connections
 			for client := range h.clients {
-				close(client.send) // This will cause the writePump to exit
+				client.outbox.close() // This will cause the writePump to exit
 			}
 			return ctx.Err() // Return the context's error (e.g., context.Canceled)
 		case client := <-h.register:
-			h.clients[client] = true
+			h.clients[client] = make(map[string]bool)
 		case client := <-h.unregister:
-			if _, ok := h.clients[client]; ok {
-				delete(h.clients, client)
-				close(client.send)
-			}
-		case message := <-h.Broadcast:
-			h.logger.Debug("broadcasting message", "message byte size", len(message))
-			for client := range h.clients {
-				select {
-				case client.send <- message:
-				default:
-					close(client.send)
-					delete(h.clients, client)
-				}
+			h.remove(client)
+		case sub := <-h.subscriptions:
+			h.subscribe(sub)
+		case message, ok := <-messages:
+			if !ok {
+				messages = nil // ctx is done
+				continue
 			}
+			h.logger.Debug("broadcasting message", "message byte size", len(message.Data), "topics", message.Topics)
+			h.broadcast(message)
+		case now := <-sweep.C:
+			h.sweepReplay(now)
+		}
+	}
+}
+
+// broadcast pushes the message to the subscribers of each of its topics, as the topic's event.
+func (h *Hub) broadcast(message Message) {
+	if len(message.Sequences) != len(message.Topics) {
+		h.logger.Error("dropping unsequenced websocket message", "type", message.Type, "topics", message.Topics)
+		return
+	}
+	for i, topic := range message.Topics {
+		event := Event{
+			Version:   EventVersion,
+			Type:      message.Type,
+			Topic:     topic,
+			Sequence:  message.Sequences[i],
+			Timestamp: message.Timestamp,
+			Payload:   message.Data,
+		}
+		eventBytes, err := json.Marshal(event)
+		if err != nil {
+			h.logger.Error("failed to marshal websocket event", "type", message.Type, "topic", topic, "err", err)
+			continue
 		}
+		buffer, ok := h.replay[topic]
+		if !ok {
+			buffer = &replayBuffer{}
+			h.replay[topic] = buffer
+		}
+		buffer.add(event.Sequence, eventBytes, time.Now())
+
+		for client := range h.topics[topic] {
+			h.send(client, outgoing{topic: topic, eventType: message.Type, data: eventBytes})
+		}
+	}
+}
+
+// sweepReplay drops the events of the topics without a recent event.
+func (h *Hub) sweepReplay(now time.Time) {
+	for topic, buffer := range h.replay {
+		if now.Sub(buffer.lastEventAt) > replayRetention {
+			delete(h.replay, topic)
+		}
+	}
+}
+
+// send queues the message in the client's outbox, applying the hub's SlowConsumerPolicy when the outbox is full.
+func (h *Hub) send(client *Client, message outgoing) {
+	dropped, ok := client.outbox.push(message, h.policy)
+	if !ok {
+		h.logger.Warn("disconnecting slow websocket client", "id", client.id, "userFp", client.userFp)
+		h.remove(client)
+		return
+	}
+	if dropped == 0 {
+		return
+	}
+	before := client.dropped
+	client.dropped += uint64(dropped)
+	if before == 0 || before/dropLogInterval != client.dropped/dropLogInterval {
+		h.logger.Warn("dropped messages for slow websocket client", "id", client.id, "userFp", client.userFp,
+			"policy", h.policy, "dropped", client.dropped)
+	}
+}
+
+// remove unsubscribes the client from every topic and closes its outbox.
+func (h *Hub) remove(client *Client) {
+	clientTopics, ok := h.clients[client]
+	if !ok {
+		return
+	}
+	for topic := range clientTopics {
+		h.leave(client, topic)
+	}
+	delete(h.clients, client)
+	client.outbox.close()
+	if client.dropped > 0 {
+		h.logger.Info("removed websocket client", "id", client.id, "userFp", client.userFp, "dropped", client.dropped)
+	}
+}
+
+func (h *Hub) subscribe(sub subscription) {
+	clientTopics, ok := h.clients[sub.client]
+	if !ok {
+		return // unregistered meanwhile
+	}
+	reply := SubscriptionReply{
+		Version: EventVersion,
+		Type:    SubscriptionEvent,
+		Action:  sub.request.Action,
+		Topic:   sub.request.Topic,
+		Id:      sub.request.Id,
+	}
+	topic := topicKey(sub.request.Topic, sub.request.Id)
+	subscribed := false
+	switch {
+	case sub.err != nil:
+		reply.Error = sub.err.Error()
+	case sub.request.Action == UnsubscribeAction:
+		h.leave(sub.client, topic)
+	case clientTopics[topic]:
+		subscribed = true // already
+	case len(clientTopics) >= maxClientTopics:
+		reply.Error = fmt.Sprintf("a client can subscribe to at most %d topics", maxClientTopics)
+	default:
+		clientTopics[topic] = true
+		if h.topics[topic] == nil {
+			h.topics[topic] = make(map[*Client]bool)
+		}
+		h.topics[topic][sub.client] = true
+		subscribed = true
+	}
+
+	var missed [][]byte
+	if subscribed && sub.request.LastSequence != nil {
+		events, complete := h.replay[topic].since(*sub.request.LastSequence)
+		if complete {
+			missed = events
+		}
+		reply.Replayed, reply.Resync = len(missed), !complete
+	}
+
+	replyBytes, err := json.Marshal(reply)
+	if err != nil {
+		h.logger.Error("failed to marshal subscription reply", "id", sub.client.id, "err", err)
+		return
+	}
+	h.send(sub.client, outgoing{data: replyBytes})
+	for _, event := range missed {
+		if _, ok := h.clients[sub.client]; !ok {
+			return // disconnected as a slow client
+		}
+		h.send(sub.client, outgoing{topic: topic, data: event})
+	}
+}
+
+// leave unsubscribes the client from the topic, the topic is dropped once it has no subscribers.
+func (h *Hub) leave(client *Client, topic string) {
+	delete(h.clients[client], topic)
+	delete(h.topics[topic], client)
+	if len(h.topics[topic]) == 0 {
+		delete(h.topics, topic)
 	}
 }
@@ -0,0 +1,147 @@
+package socket
+
+import (
+	"fmt"
+	"sync"
+)
+
+// SlowConsumerPolicy is what the hub does with a client whose outbox is full, i.e., a client reading its messages
+// slower than they are pushed. A client that misses events notices the gap in their sequence and resumes its
+// subscription (see SubscriptionRequest).
+type SlowConsumerPolicy string
+
+const (
+	// DisconnectPolicy closes the client's connection.
+	DisconnectPolicy SlowConsumerPolicy = "disconnect"
+	// DropOldestPolicy drops the oldest message waiting in the outbox.
+	DropOldestPolicy SlowConsumerPolicy = "drop_oldest"
+	// CoalescePolicy only keeps the latest waiting snapshot event (see isSnapshotEvent) of each topic and type, it
+	// supersedes the earlier ones. Delta events, e.g., a placed bid, are never dropped: the client is disconnected when
+	// coalescing doesn't make room, and resumes its subscriptions with the missed events replayed.
+	CoalescePolicy SlowConsumerPolicy = "coalesce"
+)
+
+// outboxSize is how many messages wait for a client before it's a slow consumer.
+const outboxSize = 256
+
+// ParseSlowConsumerPolicy reads a configured policy, DisconnectPolicy when unset.
+func ParseSlowConsumerPolicy(policy string) (SlowConsumerPolicy, error) {
+	switch SlowConsumerPolicy(policy) {
+	case "":
+		return DisconnectPolicy, nil
+	case DisconnectPolicy, DropOldestPolicy, CoalescePolicy:
+		return SlowConsumerPolicy(policy), nil
+	default:
+		return "", fmt.Errorf("unknown websocket slow consumer policy %q, expected %s, %s or %s", policy,
+			DisconnectPolicy, DropOldestPolicy, CoalescePolicy)
+	}
+}
+
+// outgoing is a message waiting to be written to the client, topic and eventType are empty for the replies to the
+// client's requests. eventType is empty for the replayed events too, those are never coalesced.
+type outgoing struct {
+	topic     string
+	eventType string
+	data      []byte
+}
+
+// outbox holds the messages the hub pushed to a client until its writePump writes them.
+type outbox struct {
+	mutex    sync.Mutex
+	messages []outgoing
+	closed   bool
+	// ready is signalled whenever a message is pushed or the outbox is closed
+	ready chan struct{}
+}
+
+func newOutbox() *outbox {
+	return &outbox{messages: make([]outgoing, 0, outboxSize), ready: make(chan struct{}, 1)}
+}
+
+// push queues the message, applying the policy when the outbox is full. It returns how many messages were dropped to
+// make room, and false when the client must be disconnected instead.
+func (box *outbox) push(message outgoing, policy SlowConsumerPolicy) (int, bool) {
+	box.mutex.Lock()
+	defer box.mutex.Unlock()
+	if box.closed {
+		return 0, true
+	}
+	dropped := 0
+	if len(box.messages) >= outboxSize {
+		switch policy {
+		case DropOldestPolicy:
+			box.messages = append(box.messages[:0], box.messages[1:]...)
+			dropped = 1
+		case CoalescePolicy:
+			box.messages = append(box.messages, message)
+			dropped = box.coalesce()
+			if len(box.messages) > outboxSize {
+				return dropped, false
+			}
+			box.signal()
+			return dropped, true
+		default:
+			return 0, false
+		}
+	}
+	box.messages = append(box.messages, message)
+	box.signal()
+	return dropped, true
+}
+
+// coalesce drops every waiting snapshot event superseded by a later event of the same topic and type, it returns how
+// many were dropped.
+func (box *outbox) coalesce() int {
+	latest := make(map[coalesceKey]int, len(box.messages))
+	for i, message := range box.messages {
+		if isSnapshotEvent(message.eventType) {
+			latest[message.coalesceKey()] = i
+		}
+	}
+	kept := box.messages[:0]
+	for i, message := range box.messages {
+		if !isSnapshotEvent(message.eventType) || latest[message.coalesceKey()] == i {
+			kept = append(kept, message)
+		}
+	}
+	dropped := len(box.messages) - len(kept)
+	box.messages = kept
+	return dropped
+}
+
+// coalesceKey identifies the events superseding each other, the ones of the same topic and type.
+type coalesceKey struct {
+	topic     string
+	eventType string
+}
+
+func (message outgoing) coalesceKey() coalesceKey {
+	return coalesceKey{topic: message.topic, eventType: message.eventType}
+}
+
+// pop takes the waiting messages, closed is true once the outbox is closed and every message was taken.
+func (box *outbox) pop() ([]outgoing, bool) {
+	box.mutex.Lock()
+	defer box.mutex.Unlock()
+	if len(box.messages) == 0 {
+		return nil, box.closed
+	}
+	messages := box.messages
+	box.messages = make([]outgoing, 0, outboxSize)
+	return messages, false
+}
+
+// close stops the outbox from taking messages, those already waiting are still written.
+func (box *outbox) close() {
+	box.mutex.Lock()
+	defer box.mutex.Unlock()
+	box.closed = true
+	box.signal()
+}
+
+func (box *outbox) signal() {
+	select {
+	case box.ready <- struct{}{}:
+	default: // already signalled
+	}
+}
@@ -0,0 +1,195 @@
+package socket
+
+import (
+	"fmt"
+	"testing"
+)
+
+// fullOutbox returns an outbox holding outboxSize messages, the i-th made by message(i) with data "i".
+func fullOutbox(message func(i int) outgoing) *outbox {
+	box := newOutbox()
+	for i := 0; i < outboxSize; i++ {
+		queued := message(i)
+		queued.data = []byte(fmt.Sprint(i))
+		box.messages = append(box.messages, queued)
+	}
+	return box
+}
+
+func bidPlaced(int) outgoing {
+	return outgoing{topic: "session_1", eventType: BidPlacedEvent}
+}
+
+func priceTick(int) outgoing {
+	return outgoing{topic: "session_1", eventType: PriceTickEvent}
+}
+
+func TestOutboxPush(t *testing.T) {
+	pushed := outgoing{topic: "session_1", eventType: PriceTickEvent, data: []byte("pushed")}
+	tests := []struct {
+		name    string
+		box     func() *outbox
+		message outgoing
+		policy  SlowConsumerPolicy
+		ok      bool
+		dropped int
+		size    int
+		first   string // the data of the first waiting message, when ok
+	}{
+		{
+			name:    "room left",
+			box:     newOutbox,
+			message: pushed,
+			policy:  DisconnectPolicy,
+			ok:      true,
+			size:    1,
+			first:   "pushed",
+		},
+		{
+			name:    "closed outbox ignores the message",
+			box:     func() *outbox { box := newOutbox(); box.close(); return box },
+			message: pushed,
+			policy:  DisconnectPolicy,
+			ok:      true,
+			size:    0,
+		},
+		{
+			name:    "disconnect when full",
+			box:     func() *outbox { return fullOutbox(priceTick) },
+			message: pushed,
+			policy:  DisconnectPolicy,
+			size:    outboxSize,
+		},
+		{
+			name:    "unset policy disconnects",
+			box:     func() *outbox { return fullOutbox(priceTick) },
+			message: pushed,
+			policy:  "",
+			size:    outboxSize,
+		},
+		{
+			name:    "drop oldest",
+			box:     func() *outbox { return fullOutbox(bidPlaced) },
+			message: pushed,
+			policy:  DropOldestPolicy,
+			ok:      true,
+			dropped: 1,
+			size:    outboxSize,
+			first:   "1",
+		},
+		{
+			name:    "coalesce superseded snapshots",
+			box:     func() *outbox { return fullOutbox(priceTick) },
+			message: pushed,
+			policy:  CoalescePolicy,
+			ok:      true,
+			dropped: outboxSize,
+			size:    1,
+			first:   "pushed",
+		},
+		{
+			name: "coalesce keeps the deltas",
+			box: func() *outbox {
+				return fullOutbox(func(i int) outgoing {
+					if i == 0 {
+						return priceTick(i)
+					}
+					return bidPlaced(i)
+				})
+			},
+			message: pushed,
+			policy:  CoalescePolicy,
+			ok:      true,
+			dropped: 1,
+			size:    outboxSize,
+			first:   "1",
+		},
+		{
+			name:    "coalesce disconnects when only deltas wait",
+			box:     func() *outbox { return fullOutbox(bidPlaced) },
+			message: pushed,
+			policy:  CoalescePolicy,
+			size:    outboxSize + 1,
+		},
+		{
+			name: "coalesce keeps the snapshots of other topics",
+			box: func() *outbox {
+				return fullOutbox(func(i int) outgoing {
+					return outgoing{topic: fmt.Sprintf("session_%d", i+2), eventType: PriceTickEvent}
+				})
+			},
+			message: pushed,
+			policy:  CoalescePolicy,
+			size:    outboxSize + 1,
+		},
+		{
+			name: "coalesce keeps the snapshots of other types",
+			box: func() *outbox {
+				return fullOutbox(func(int) outgoing { return outgoing{topic: "session_1", eventType: SettlementEvent} })
+			},
+			message: pushed,
+			policy:  CoalescePolicy,
+			dropped: outboxSize - 1,
+			ok:      true,
+			size:    2,
+			first:   fmt.Sprint(outboxSize - 1),
+		},
+		{
+			name: "coalesce never drops replies and replays",
+			box: func() *outbox {
+				return fullOutbox(func(i int) outgoing {
+					if i%2 == 0 {
+						return outgoing{}
+					}
+					return outgoing{topic: "session_1"}
+				})
+			},
+			message: outgoing{data: []byte("reply")},
+			policy:  CoalescePolicy,
+			size:    outboxSize + 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			box := test.box()
+			dropped, ok := box.push(test.message, test.policy)
+			if ok != test.ok || dropped != test.dropped {
+				t.Fatalf("push = (%d, %t), want (%d, %t)", dropped, ok, test.dropped, test.ok)
+			}
+			if len(box.messages) != test.size {
+				t.Fatalf("%d waiting messages, want %d", len(box.messages), test.size)
+			}
+			if !ok || test.size == 0 {
+				return
+			}
+			if first := string(box.messages[0].data); first != test.first {
+				t.Errorf("first waiting message = %s, want %s", first, test.first)
+			}
+			if last := string(box.messages[len(box.messages)-1].data); last != string(test.message.data) {
+				t.Errorf("last waiting message = %s, want %s", last, test.message.data)
+			}
+		})
+	}
+}
+
+func TestParseSlowConsumerPolicy(t *testing.T) {
+	tests := []struct {
+		policy  string
+		want    SlowConsumerPolicy
+		wantErr bool
+	}{
+		{policy: "", want: DisconnectPolicy},
+		{policy: "disconnect", want: DisconnectPolicy},
+		{policy: "drop_oldest", want: DropOldestPolicy},
+		{policy: "coalesce", want: CoalescePolicy},
+		{policy: "drop_newest", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.policy, func(t *testing.T) {
+			policy, err := ParseSlowConsumerPolicy(test.policy)
+			if (err != nil) != test.wantErr || policy != test.want {
+				t.Errorf("got (%s, %v), want %s", policy, err, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package socket
+
+import (
+	"encoding/json"
+	"log/slog"
+	"xrf197ilz35aq2/core/domain"
+)
+
+// SessionEvents publishes the sessions' transitions and settlements through the hub, see service.SessionEvents.
+type SessionEvents struct {
+	hub *Hub
+	log slog.Logger
+}
+
+// Transitioned publishes the session's transition as a SessionTransitionedEvent.
+func (events *SessionEvents) Transitioned(transition domain.SessionTransition) {
+	messageBytes, err := json.Marshal(transition)
+	if err != nil {
+		events.log.Error("failed to marshal session transition for websocket listeners", "sessionId", transition.SessionId, "err", err)
+		return
+	}
+	events.hub.Publish(NewMessage(SessionTransitionedEvent, transition.AssetId, transition.SessionId, messageBytes))
+}
+
+// Settled publishes the session's settlement as a SettlementEvent.
+func (events *SessionEvents) Settled(settlement *domain.Settlement) {
+	messageBytes, err := json.Marshal(settlement)
+	if err != nil {
+		events.log.Error("failed to marshal settlement for websocket listeners", "sessionId", settlement.SessionId, "err", err)
+		return
+	}
+	events.hub.Publish(NewMessage(SettlementEvent, settlement.AssetId, settlement.SessionId, messageBytes))
+}
+
+func NewSessionEvents(hub *Hub, log slog.Logger) *SessionEvents {
+	return &SessionEvents{hub: hub, log: log}
+}
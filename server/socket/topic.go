@@ -0,0 +1,99 @@
+package socket
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	AssetTopic   = "asset"
+	SessionTopic = "session"
+
+	SubscribeAction   = "subscribe"
+	UnsubscribeAction = "unsubscribe"
+
+	// maxClientTopics is how many topics a single client can be subscribed to at once.
+	maxClientTopics = 100
+	// maxTopicIdLength is the length of the longest asset or session id.
+	maxTopicIdLength = 255
+)
+
+// Message is broadcast to the clients subscribed to any of its topics, as an Event of each topic. The Backend numbers
+// the message in every topic (Sequences, by topic index) when it's published.
+type Message struct {
+	Type      string    `json:"type"`
+	Topics    []string  `json:"topics"`
+	Sequences []uint64  `json:"sequences"`
+	Timestamp time.Time `json:"timestamp"`
+	Data      []byte    `json:"data"`
+}
+
+// NewMessage is an event about the asset's session, for the subscribers of the asset and of the session. data is the
+// JSON payload of the event.
+func NewMessage(eventType string, assetId string, sessionId string, data []byte) Message {
+	topics := make([]string, 0, 2)
+	if assetId != "" {
+		topics = append(topics, topicKey(AssetTopic, assetId))
+	}
+	if sessionId != "" {
+		topics = append(topics, topicKey(SessionTopic, sessionId))
+	}
+	return Message{Type: eventType, Topics: topics, Data: data}
+}
+
+func topicKey(topic string, id string) string {
+	return topic + ":" + id
+}
+
+// SubscriptionRequest is sent by a client to (un)subscribe to the messages of an asset or a session, e.g.
+// {"action": "subscribe", "topic": "asset", "id": "42"}. A client resuming a subscription, e.g. after reconnecting,
+// sets LastSequence to the sequence of the last event of the topic it got, the events it missed are replayed.
+type SubscriptionRequest struct {
+	Action       string  `json:"action"`
+	Topic        string  `json:"topic"`
+	Id           string  `json:"id"`
+	LastSequence *uint64 `json:"lastSequence,omitempty"`
+}
+
+func (req SubscriptionRequest) validate() error {
+	if req.Action != SubscribeAction && req.Action != UnsubscribeAction {
+		return fmt.Errorf("action must be %s or %s", SubscribeAction, UnsubscribeAction)
+	}
+	if req.Topic != AssetTopic && req.Topic != SessionTopic {
+		return fmt.Errorf("topic must be %s or %s", AssetTopic, SessionTopic)
+	}
+	if req.Id == "" || len(req.Id) > maxTopicIdLength {
+		return fmt.Errorf("id must be set and at most %d characters long", maxTopicIdLength)
+	}
+	return nil
+}
+
+// SubscriptionReply answers a SubscriptionRequest, Error is set when it was refused. Replayed is how many missed events
+// follow the reply, Resync is set when the missed events aren't all kept anymore and the client must fetch the current
+// state of the topic instead.
+type SubscriptionReply struct {
+	Version  int    `json:"version"`
+	Type     string `json:"type"`
+	Action   string `json:"action"`
+	Topic    string `json:"topic,omitempty"`
+	Id       string `json:"id,omitempty"`
+	Replayed int    `json:"replayed,omitempty"`
+	Resync   bool   `json:"resync,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// subscription is a client's SubscriptionRequest as handed to the hub, err is set when the request was invalid.
+type subscription struct {
+	client  *Client
+	request SubscriptionRequest
+	err     error
+}
+
+func parseSubscription(client *Client, message []byte) subscription {
+	var request SubscriptionRequest
+	if err := json.Unmarshal(message, &request); err != nil {
+		return subscription{client: client, err: fmt.Errorf("message is not a subscription request")}
+	}
+	return subscription{client: client, request: request, err: request.validate()}
+}
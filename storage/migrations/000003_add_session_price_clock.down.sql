@@ -0,0 +1,4 @@
+ALTER TABLE bid_session
+    DROP COLUMN IF EXISTS starting_price,
+    DROP COLUMN IF EXISTS price_step,
+    DROP COLUMN IF EXISTS price_step_seconds;
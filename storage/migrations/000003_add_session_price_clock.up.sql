@@ -0,0 +1,6 @@
+-- DutchAuction price clock: the price starts at starting_price and drops by price_step every price_step_seconds
+-- until it reaches the session's reserve_price
+ALTER TABLE bid_session
+    ADD COLUMN IF NOT EXISTS starting_price DOUBLE PRECISION NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS price_step DOUBLE PRECISION NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS price_step_seconds BIGINT NOT NULL DEFAULT 0;
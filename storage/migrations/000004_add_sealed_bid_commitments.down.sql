@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS bid_commitment;
+DROP TYPE IF EXISTS commitment_status;
+ALTER TABLE bid_session
+    DROP COLUMN IF EXISTS reveal_seconds;
@@ -0,0 +1,23 @@
+-- Sealed auctions, how long bidders have to reveal their bids after the session's end_time
+ALTER TABLE bid_session
+    ADD COLUMN IF NOT EXISTS reveal_seconds BIGINT NOT NULL DEFAULT 0;
+
+CREATE TYPE commitment_status AS ENUM (
+    'COMMITTED',
+    'REVEALED'
+);
+
+CREATE TABLE IF NOT EXISTS bid_commitment (
+    id VARCHAR(255) PRIMARY KEY,
+    asset_id VARCHAR(255) NOT NULL,
+    bidder_fp VARCHAR(255) NOT NULL,
+    session_id VARCHAR(255) NOT NULL,
+    commitment CHAR(64) NOT NULL,
+    status commitment_status NOT NULL,
+    committed_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    bid_id VARCHAR(255),
+    nonce VARCHAR(255),
+    amount DOUBLE PRECISION,
+    revealed_at TIMESTAMP WITH TIME ZONE,
+    UNIQUE (session_id, bidder_fp)
+);
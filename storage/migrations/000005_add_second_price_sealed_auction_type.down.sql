@@ -0,0 +1,8 @@
+-- PostgreSQL can't drop a value from an ENUM, and the sessions of the type can't be moved to another type without
+-- changing how they are settled: the rollback is refused while any exists.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM bid_session WHERE auction_type = 'SecondPriceSealedAuction') THEN
+        RAISE EXCEPTION 'bid_session has SecondPriceSealedAuction sessions, remove them before rolling back';
+    END IF;
+END $$;
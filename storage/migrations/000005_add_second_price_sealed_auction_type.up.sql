@@ -0,0 +1 @@
+ALTER TYPE session_action_type ADD VALUE IF NOT EXISTS 'SecondPriceSealedAuction';
@@ -0,0 +1,3 @@
+ALTER TABLE bid_session
+    DROP COLUMN IF EXISTS unit_price,
+    DROP COLUMN IF EXISTS available_quantity;
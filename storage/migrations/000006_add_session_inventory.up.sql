@@ -0,0 +1,4 @@
+-- FixedPriceAuction, units are sold at unit_price until the available_quantity is sold out
+ALTER TABLE bid_session
+    ADD COLUMN IF NOT EXISTS unit_price DOUBLE PRECISION NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS available_quantity DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (available_quantity >= 0);
@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS settlement_award;
+DROP TABLE IF EXISTS session_settlement;
+DROP TYPE IF EXISTS settlement_status;
+-- PostgreSQL can't drop a value from an ENUM, settled bids are moved back to the status they had before the settlement
+UPDATE asset_bid SET status = 'ACCEPTED' WHERE status IN ('WON', 'LOST');
+ALTER TABLE asset_bid
+    DROP COLUMN IF EXISTS quantity;
+ALTER TABLE bid_session
+    DROP COLUMN IF EXISTS highest_bid_id,
+    DROP COLUMN IF EXISTS highest_bidder_fp;
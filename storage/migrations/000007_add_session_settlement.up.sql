@@ -0,0 +1,40 @@
+-- the session tracks its leading bid, it wins English and Dutch auctions when the session is settled
+ALTER TABLE bid_session
+    ADD COLUMN IF NOT EXISTS highest_bid_id VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS highest_bidder_fp VARCHAR(255) NOT NULL DEFAULT '';
+
+-- units bought by a FixedPriceAuction bid
+ALTER TABLE asset_bid
+    ADD COLUMN IF NOT EXISTS quantity DOUBLE PRECISION NOT NULL DEFAULT 1;
+
+ALTER TYPE bid_status ADD VALUE IF NOT EXISTS 'WON';
+ALTER TYPE bid_status ADD VALUE IF NOT EXISTS 'LOST';
+
+CREATE TYPE settlement_status AS ENUM (
+    'AWAITING_CONFIRMATION',
+    'EXECUTED',
+    'NO_SALE'
+);
+
+CREATE TABLE IF NOT EXISTS session_settlement (
+    id VARCHAR(255) PRIMARY KEY,
+    session_id VARCHAR(255) NOT NULL UNIQUE,
+    asset_id VARCHAR(255) NOT NULL,
+    seller_fp VARCHAR(255) NOT NULL,
+    auction_type VARCHAR(255) NOT NULL,
+    status settlement_status NOT NULL,
+    reserve_met BOOLEAN NOT NULL,
+    reserve_price DOUBLE PRECISION NOT NULL,
+    settled_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    executed_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE TABLE IF NOT EXISTS settlement_award (
+    settlement_id VARCHAR(255) NOT NULL REFERENCES session_settlement (id) ON DELETE CASCADE,
+    bid_id VARCHAR(255) NOT NULL,
+    winner_fp VARCHAR(255) NOT NULL,
+    bid_amount DOUBLE PRECISION NOT NULL,
+    clearing_price DOUBLE PRECISION NOT NULL,
+    quantity DOUBLE PRECISION NOT NULL,
+    PRIMARY KEY (settlement_id, bid_id)
+);
@@ -0,0 +1,5 @@
+ALTER TYPE session_action_type ADD VALUE IF NOT EXISTS 'MultiUnitAuction';
+
+-- MultiUnitAuction, how the units on offer (available_quantity) are priced: UNIFORM or DISCRIMINATORY
+ALTER TABLE bid_session
+    ADD COLUMN IF NOT EXISTS pricing_rule VARCHAR(32) NOT NULL DEFAULT '';
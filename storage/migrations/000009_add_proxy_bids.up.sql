@@ -0,0 +1,11 @@
+-- EnglishAuction proxy bids, the hidden maximum a bidder is willing to pay in a session
+CREATE TABLE IF NOT EXISTS proxy_bid (
+    id VARCHAR(255) PRIMARY KEY,
+    asset_id VARCHAR(255) NOT NULL,
+    bidder_fp VARCHAR(255) NOT NULL,
+    session_id VARCHAR(255) NOT NULL,
+    max_amount DOUBLE PRECISION NOT NULL CHECK (max_amount > 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    UNIQUE (session_id, bidder_fp)
+);
@@ -0,0 +1,5 @@
+ALTER TABLE bid_session
+    DROP COLUMN IF EXISTS soft_close_seconds,
+    DROP COLUMN IF EXISTS extension_seconds,
+    DROP COLUMN IF EXISTS max_extension_seconds,
+    DROP COLUMN IF EXISTS extended_seconds;
@@ -0,0 +1,7 @@
+-- soft close (anti-sniping), a bid accepted within the final soft_close_seconds extends the end_time by
+-- extension_seconds, until the extensions (extended_seconds) reach max_extension_seconds, no cap when 0
+ALTER TABLE bid_session
+    ADD COLUMN IF NOT EXISTS soft_close_seconds BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS extension_seconds BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS max_extension_seconds BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS extended_seconds BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+-- PostgreSQL can't drop a value from an ENUM, retracted bids are moved to the closest status instead
+UPDATE asset_bid SET status = 'REJECTED', accepted = FALSE WHERE status = 'CANCELLED';
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS asset_bid_session_keyset_idx;
+DROP INDEX IF EXISTS asset_bid_placed_by_keyset_idx;
@@ -0,0 +1,3 @@
+-- keyset pagination of bids, newest first
+CREATE INDEX IF NOT EXISTS asset_bid_placed_by_keyset_idx ON asset_bid (placed_by, asset_id, placed_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS asset_bid_session_keyset_idx ON asset_bid (session_id, asset_id, placed_at DESC, id DESC);
@@ -0,0 +1,23 @@
+ALTER TABLE settlement_award
+    ALTER COLUMN bid_amount TYPE DOUBLE PRECISION USING bid_amount::DOUBLE PRECISION,
+    ALTER COLUMN clearing_price TYPE DOUBLE PRECISION USING clearing_price::DOUBLE PRECISION;
+
+ALTER TABLE session_settlement
+    ALTER COLUMN reserve_price TYPE DOUBLE PRECISION USING reserve_price::DOUBLE PRECISION;
+
+ALTER TABLE proxy_bid
+    ALTER COLUMN max_amount TYPE DOUBLE PRECISION USING max_amount::DOUBLE PRECISION;
+
+ALTER TABLE bid_commitment
+    ALTER COLUMN amount TYPE DOUBLE PRECISION USING amount::DOUBLE PRECISION;
+
+ALTER TABLE asset_bid
+    ALTER COLUMN amount TYPE DOUBLE PRECISION USING amount::DOUBLE PRECISION;
+
+ALTER TABLE bid_session
+    ALTER COLUMN reserve_price TYPE DOUBLE PRECISION USING reserve_price::DOUBLE PRECISION,
+    ALTER COLUMN current_highest_bid TYPE DOUBLE PRECISION USING current_highest_bid::DOUBLE PRECISION,
+    ALTER COLUMN bid_increment_amount TYPE DOUBLE PRECISION USING bid_increment_amount::DOUBLE PRECISION,
+    ALTER COLUMN starting_price TYPE DOUBLE PRECISION USING starting_price::DOUBLE PRECISION,
+    ALTER COLUMN price_step TYPE DOUBLE PRECISION USING price_step::DOUBLE PRECISION,
+    ALTER COLUMN unit_price TYPE DOUBLE PRECISION USING unit_price::DOUBLE PRECISION;
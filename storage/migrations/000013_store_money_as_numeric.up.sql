@@ -0,0 +1,24 @@
+-- money is stored as an exact decimal, DOUBLE PRECISION loses cents on large amounts
+ALTER TABLE bid_session
+    ALTER COLUMN reserve_price TYPE NUMERIC(38, 9) USING reserve_price::NUMERIC(38, 9),
+    ALTER COLUMN current_highest_bid TYPE NUMERIC(38, 9) USING current_highest_bid::NUMERIC(38, 9),
+    ALTER COLUMN bid_increment_amount TYPE NUMERIC(38, 9) USING bid_increment_amount::NUMERIC(38, 9),
+    ALTER COLUMN starting_price TYPE NUMERIC(38, 9) USING starting_price::NUMERIC(38, 9),
+    ALTER COLUMN price_step TYPE NUMERIC(38, 9) USING price_step::NUMERIC(38, 9),
+    ALTER COLUMN unit_price TYPE NUMERIC(38, 9) USING unit_price::NUMERIC(38, 9);
+
+ALTER TABLE asset_bid
+    ALTER COLUMN amount TYPE NUMERIC(38, 9) USING amount::NUMERIC(38, 9);
+
+ALTER TABLE bid_commitment
+    ALTER COLUMN amount TYPE NUMERIC(38, 9) USING amount::NUMERIC(38, 9);
+
+ALTER TABLE proxy_bid
+    ALTER COLUMN max_amount TYPE NUMERIC(38, 9) USING max_amount::NUMERIC(38, 9);
+
+ALTER TABLE session_settlement
+    ALTER COLUMN reserve_price TYPE NUMERIC(38, 9) USING reserve_price::NUMERIC(38, 9);
+
+ALTER TABLE settlement_award
+    ALTER COLUMN bid_amount TYPE NUMERIC(38, 9) USING bid_amount::NUMERIC(38, 9),
+    ALTER COLUMN clearing_price TYPE NUMERIC(38, 9) USING clearing_price::NUMERIC(38, 9);
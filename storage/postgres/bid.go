@@ -2,8 +2,10 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 	"xrf197ilz35aq2/core/domain"
 	"xrf197ilz35aq2/storage/postgres/dao"
 
@@ -11,12 +13,22 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// bidColumns are the asset_bid columns selected by every bid query, in the order scanBid reads them.
+const bidColumns = `id, amount, quantity, asset_id, status, accepted, placed_by, placed_at, last_until, session_id`
+
 type BidRepository interface {
 	CreateBid(ctx context.Context, request domain.Bid) (string, error)
 	BatchCreateBids(ctx context.Context, bids []domain.Bid) (int64, error)
 	CreateBidsCopyFrom(ctx context.Context, bids []domain.Bid) (int64, error)
-	FetchBidsByUserFp(ctx context.Context, offset int64, limit int64, userFp string) ([]domain.Bid, error)
-	FetchBidsByAssetIdAndSessionId(ctx context.Context, offset int64, limit int64, assetId string, sessionId string) ([]domain.Bid, error)
+	FetchBidsByUserFp(ctx context.Context, userFp string, assetId string, after *BidCursor, limit int64) ([]domain.Bid, error)
+	CountBidsByUserFp(ctx context.Context, userFp string, assetId string) (int64, error)
+	FetchSessionBids(ctx context.Context, assetId string, sessionId string, after *BidCursor, limit int64) ([]domain.Bid, error)
+	CountSessionBids(ctx context.Context, assetId string, sessionId string) (int64, error)
+	FetchAcceptedBidsBySession(ctx context.Context, sessionId string) ([]domain.Bid, error)
+	FindHighestAcceptedBid(ctx context.Context, sessionId string) (*domain.Bid, error)
+	MarkSessionBids(ctx context.Context, sessionId string, winningBidIds []string) (int64, error)
+	FindById(ctx context.Context, bidId string) (*domain.Bid, error)
+	CancelBid(ctx context.Context, bidId string) (bool, error)
 }
 
 type bidRepository struct {
@@ -26,11 +38,12 @@ type bidRepository struct {
 
 func (repo *bidRepository) CreateBid(ctx context.Context, newBid domain.Bid) (string, error) {
 	sql := `
-INSERT INTO bid (id, amount, asset_id, bid_status, accepted, placed_by, placed_at, last_until, session_id)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+INSERT INTO asset_bid (id, amount, asset_id, status, accepted, placed_by, placed_at, last_until, session_id, quantity)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 RETURNING id`
 	var id string
 	err := repo.dbPool.QueryRow(ctx, sql,
+		newBid.Id,
 		newBid.Amount,
 		newBid.AssetId,
 		newBid.Status,
@@ -39,6 +52,7 @@ RETURNING id`
 		newBid.Timestamp,
 		newBid.LastUntil,
 		newBid.SessionId,
+		newBid.Quantity,
 	).Scan(&id)
 	if err != nil {
 		return "", err
@@ -64,8 +78,8 @@ func (repo *bidRepository) BatchCreateBids(ctx context.Context, bids []domain.Bi
 	batch := &pgx.Batch{}
 	for _, bid := range bids {
 		batch.Queue(`
-INSERT INTO bid (id, amount, asset_id, bid_status, accepted, placed_by, placed_at, last_until, session_id)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+INSERT INTO asset_bid (id, amount, asset_id, status, accepted, placed_by, placed_at, last_until, session_id, quantity)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
 			bid.Id,
 			bid.Amount,
 			bid.AssetId,
@@ -74,7 +88,8 @@ VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
 			bid.UserFp,
 			bid.Timestamp,
 			bid.LastUntil,
-			bid.SessionId)
+			bid.SessionId,
+			bid.Quantity)
 	}
 
 	results := tx.SendBatch(ctx, batch)
@@ -109,6 +124,7 @@ func (repo *bidRepository) CreateBidsCopyFrom(ctx context.Context, bids []domain
 			bid.SessionId,
 			bid.LastUntil,
 			bid.Timestamp,
+			bid.Quantity,
 		}, nil
 	})
 	columnNames := dao.GetBidColumnName()
@@ -119,41 +135,100 @@ func (repo *bidRepository) CreateBidsCopyFrom(ctx context.Context, bids []domain
 	return count, nil
 }
 
-func (repo *bidRepository) FetchBidsByUserFp(ctx context.Context, offset int64, limit int64, userFp string) ([]domain.Bid, error) {
+// BidCursor is the position of a bid among bids listed newest first, a page starts after it (keyset pagination).
+type BidCursor struct {
+	PlacedAt time.Time
+	Id       string
+}
+
+// FetchBidsByUserFp returns up to limit of the user's bids, on the asset when assetId isn't empty, newest first and
+// after the cursor when there's one.
+func (repo *bidRepository) FetchBidsByUserFp(ctx context.Context, userFp string, assetId string, after *BidCursor, limit int64) ([]domain.Bid, error) {
+	placedAt, id := after.keyset()
 	sql := `
-SELECT id, amount, asset_id, bid_status, accepted, placed_by, placed_at, last_until, session_id
-FROM bid
+SELECT ` + bidColumns + `
+FROM asset_bid
 WHERE placed_by = $1
-ORDER BY placed_at DESC
-LIMIT $2 OFFSET $3`
-	rows, err := repo.dbPool.Query(ctx, sql, userFp, limit, offset)
+AND ($2 = '' OR asset_id = $2)
+AND ($3::timestamptz IS NULL OR (placed_at, id) < ($3, $4))
+ORDER BY placed_at DESC, id DESC
+LIMIT $5`
+	rows, err := repo.dbPool.Query(ctx, sql, userFp, assetId, placedAt, id, limit)
 	if err != nil {
 		return nil, fmt.Errorf("error fetching bids by user_fp: %w", err)
 	}
+	return repo.scanBids(rows)
+}
+
+// CountBidsByUserFp counts the user's bids, on the asset when assetId isn't empty.
+func (repo *bidRepository) CountBidsByUserFp(ctx context.Context, userFp string, assetId string) (int64, error) {
+	var count int64
+	err := repo.dbPool.QueryRow(ctx, `
+SELECT COUNT(*)
+FROM asset_bid
+WHERE placed_by = $1
+AND ($2 = '' OR asset_id = $2)`, userFp, assetId).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("error counting bids by user_fp: %w", err)
+	}
+	return count, nil
+}
+
+// FetchSessionBids returns up to limit of the bids placed on the asset in the session, newest first and after the
+// cursor when there's one.
+func (repo *bidRepository) FetchSessionBids(ctx context.Context, assetId string, sessionId string, after *BidCursor, limit int64) ([]domain.Bid, error) {
+	placedAt, id := after.keyset()
+	sql := `
+SELECT ` + bidColumns + `
+FROM asset_bid
+WHERE asset_id = $1 AND session_id = $2
+AND ($3::timestamptz IS NULL OR (placed_at, id) < ($3, $4))
+ORDER BY placed_at DESC, id DESC
+LIMIT $5`
+	rows, err := repo.dbPool.Query(ctx, sql, assetId, sessionId, placedAt, id, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching bids by asset_id and session_id: %w", err)
+	}
+	return repo.scanBids(rows)
+}
+
+// CountSessionBids counts the bids placed on the asset in the session.
+func (repo *bidRepository) CountSessionBids(ctx context.Context, assetId string, sessionId string) (int64, error) {
+	var count int64
+	err := repo.dbPool.QueryRow(ctx, `
+SELECT COUNT(*)
+FROM asset_bid
+WHERE asset_id = $1 AND session_id = $2`, assetId, sessionId).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("error counting bids by asset_id and session_id: %w", err)
+	}
+	return count, nil
+}
+
+// keyset is the cursor's position as query arguments, nil placed_at for no cursor.
+func (cursor *BidCursor) keyset() (*time.Time, string) {
+	if cursor == nil {
+		return nil, ""
+	}
+	return &cursor.PlacedAt, cursor.Id
+}
+
+// scanBids scans the rows of bidColumns, rows that fail to scan are skipped and reported with a RowScanError along
+// with the scanned bids.
+func (repo *bidRepository) scanBids(rows pgx.Rows) ([]domain.Bid, error) {
 	// Close the rows when we're done with them'
 	defer rows.Close()
 	var bids []domain.Bid
 	rowScanError := &RowScanError{}
 	for rows.Next() {
-		var bid domain.Bid
-		err = rows.Scan(
-			&bid.Id,
-			&bid.Amount,
-			&bid.AssetId,
-			&bid.Status,
-			&bid.Accepted,
-			&bid.UserFp,
-			&bid.Timestamp,
-			&bid.LastUntil,
-			&bid.SessionId,
-		)
+		bid, err := scanBid(rows)
 		if err != nil {
 			rowScanError.Err = err // ⚠️!!IMPORTANT!! this will always overwrite the rowScanError error
 			rowScanError.SkipCount++
 			repo.log.Error("error scanning bid record", "err", err) // log errors encountered while scanning
 			continue                                                // skip this bid and continue to the next one
 		}
-		bids = append(bids, bid)
+		bids = append(bids, *bid)
 	}
 	if rowScanError.Err != nil {
 		return bids, rowScanError // return the error encountered while scanning bids and the successfully scanned bids
@@ -165,51 +240,117 @@ LIMIT $2 OFFSET $3`
 	return bids, nil
 }
 
-func (repo *bidRepository) FetchBidsByAssetIdAndSessionId(ctx context.Context, offset int64, limit int64, assetId string, sessionId string) ([]domain.Bid, error) {
-	sql := `
-SELECT id, amount, asset_id, bid_status, accepted, placed_by, placed_at, last_until, session_id
-FROM bid
-WHERE asset_id = $1 AND session_id = $2
-ORDER BY placed_at DESC
-LIMIT $3 OFFSET $4`
-	rows, err := repo.dbPool.Query(ctx, sql, assetId, sessionId, limit, offset)
+func scanBid(row pgx.Row) (*domain.Bid, error) {
+	var bid domain.Bid
+	err := row.Scan(
+		&bid.Id,
+		&bid.Amount,
+		&bid.Quantity,
+		&bid.AssetId,
+		&bid.Status,
+		&bid.Accepted,
+		&bid.UserFp,
+		&bid.Timestamp,
+		&bid.LastUntil,
+		&bid.SessionId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &bid, nil
+}
 
+// FetchAcceptedBidsBySession returns the bids the session's auction accepted, oldest first.
+func (repo *bidRepository) FetchAcceptedBidsBySession(ctx context.Context, sessionId string) ([]domain.Bid, error) {
+	sql := `
+SELECT ` + bidColumns + `
+FROM asset_bid
+WHERE session_id = $1 AND status = $2
+ORDER BY placed_at`
+	rows, err := repo.dbPool.Query(ctx, sql, sessionId, domain.AcceptedBid)
 	if err != nil {
-		return nil, fmt.Errorf("error fetching bids by asset_id and session_id: %w", err)
+		return nil, fmt.Errorf("error fetching accepted bids by session_id: %w", err)
 	}
-	// Close the rows when we're done with them'
 	defer rows.Close()
 	var bids []domain.Bid
-	rowScanError := &RowScanError{}
 	for rows.Next() {
-		var bid domain.Bid
-		err = rows.Scan(
-			&bid.Id,
-			&bid.Amount,
-			&bid.AssetId,
-			&bid.Status,
-			&bid.Accepted,
-			&bid.UserFp,
-			&bid.Timestamp,
-		)
+		bid, err := scanBid(rows)
 		if err != nil {
-			rowScanError.Err = err
-			rowScanError.SkipCount++
-			repo.log.Error("error scanning bid record", "err", err)
-			continue
+			return nil, fmt.Errorf("error scanning accepted bid record: %w", err)
 		}
-		bids = append(bids, bid)
+		bids = append(bids, *bid)
 	}
-	if rowScanError.Err != nil {
-		return bids, rowScanError
-	}
-
 	if err := rows.Err(); err != nil {
-		return bids, fmt.Errorf("error scanning bid records: %w", err)
+		return nil, fmt.Errorf("error scanning accepted bid records: %w", err)
 	}
 	return bids, nil
 }
 
+// FindHighestAcceptedBid returns the session's accepted bid with the highest amount, the earliest of equal ones, or nil
+// when the session has none.
+func (repo *bidRepository) FindHighestAcceptedBid(ctx context.Context, sessionId string) (*domain.Bid, error) {
+	bid, err := scanBid(repo.dbPool.QueryRow(ctx, `
+SELECT `+bidColumns+`
+FROM asset_bid
+WHERE session_id = $1 AND status = $2
+ORDER BY amount DESC, placed_at
+LIMIT 1`, sessionId, domain.AcceptedBid))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error fetching highest accepted bid by session_id: %w", err)
+	}
+	return bid, nil
+}
+
+// MarkSessionBids marks the winningBidIds of the settled session as won and its other bids, but the rejected ones, as lost.
+func (repo *bidRepository) MarkSessionBids(ctx context.Context, sessionId string, winningBidIds []string) (int64, error) {
+	results, err := repo.dbPool.Exec(ctx, `
+UPDATE asset_bid
+SET status = CASE WHEN id = ANY($1) THEN $2::bid_status ELSE $3::bid_status END
+WHERE session_id = $4 AND status IN ($5, $6)`,
+		winningBidIds,
+		domain.WonBid,
+		domain.LostBid,
+		sessionId,
+		domain.PendingBid,
+		domain.AcceptedBid,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("error marking settled session bids: %w", err)
+	}
+	return results.RowsAffected(), nil
+}
+
+// FindById returns the persisted bid, or nil when it's not persisted (yet).
+func (repo *bidRepository) FindById(ctx context.Context, bidId string) (*domain.Bid, error) {
+	bid, err := scanBid(repo.dbPool.QueryRow(ctx, `
+SELECT `+bidColumns+`
+FROM asset_bid
+WHERE id = $1`, bidId))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error fetching bid by id: %w", err)
+	}
+	return bid, nil
+}
+
+// CancelBid marks the persisted bid as retracted, only if it's still pending or accepted. It returns false otherwise,
+// e.g., when the bid is not persisted yet.
+func (repo *bidRepository) CancelBid(ctx context.Context, bidId string) (bool, error) {
+	results, err := repo.dbPool.Exec(ctx, `
+UPDATE asset_bid
+SET status = $1, accepted = FALSE
+WHERE id = $2 AND status IN ($3, $4)`, domain.CancelledBid, bidId, domain.PendingBid, domain.AcceptedBid)
+	if err != nil {
+		return false, fmt.Errorf("error cancelling bid: %w", err)
+	}
+	return results.RowsAffected() == 1, nil
+}
+
 func NewBidRepo(dbPool *pgxpool.Pool, log slog.Logger) BidRepository {
 	return &bidRepository{
 		dbPool: dbPool,
@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"xrf197ilz35aq2/core/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type CommitmentRepository interface {
+	Save(ctx context.Context, commitment *domain.BidCommitment) (string, error)
+	FindBySessionAndBidder(ctx context.Context, sessionId string, userFp string) (*domain.BidCommitment, error)
+	FindRevealedBySession(ctx context.Context, sessionId string) ([]domain.BidCommitment, error)
+	MarkRevealed(ctx context.Context, commitment *domain.BidCommitment) (bool, error)
+}
+
+type commitmentRepository struct {
+	log    slog.Logger
+	dbPool *pgxpool.Pool
+}
+
+// Save stores the bidder's commitment for the session. A bidder has one commitment per session, committing again
+// before revealing replaces the previous commitment but keeps its id.
+func (repo *commitmentRepository) Save(ctx context.Context, commitment *domain.BidCommitment) (string, error) {
+	sql := `
+INSERT INTO bid_commitment (id, asset_id, bidder_fp, session_id, commitment, status, committed_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (session_id, bidder_fp) DO UPDATE
+SET commitment = EXCLUDED.commitment, committed_at = EXCLUDED.committed_at
+WHERE bid_commitment.status = $6
+RETURNING id`
+	var id string
+	err := repo.dbPool.QueryRow(ctx, sql,
+		commitment.Id,
+		commitment.AssetId,
+		commitment.UserFp,
+		commitment.SessionId,
+		commitment.Commitment,
+		commitment.Status,
+		commitment.CommittedAt,
+	).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", fmt.Errorf("commitment of bidder in session %s is already revealed", commitment.SessionId)
+		}
+		return "", fmt.Errorf("error saving bid commitment: %w", err)
+	}
+	commitment.Id = id
+	return id, nil
+}
+
+// FindBySessionAndBidder returns the bidder's commitment for the session, or nil when the bidder didn't commit a bid.
+func (repo *commitmentRepository) FindBySessionAndBidder(ctx context.Context, sessionId string, userFp string) (*domain.BidCommitment, error) {
+	sql := `
+SELECT id, asset_id, bidder_fp, session_id, commitment, status, committed_at,
+       COALESCE(bid_id, ''), COALESCE(nonce, ''), COALESCE(amount, 0), revealed_at
+FROM bid_commitment
+WHERE session_id = $1 AND bidder_fp = $2`
+	commitment, err := scanCommitment(repo.dbPool.QueryRow(ctx, sql, sessionId, userFp))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error fetching bid commitment: %w", err)
+	}
+	return commitment, nil
+}
+
+// FindRevealedBySession returns the commitments of the session whose bids were validly revealed.
+func (repo *commitmentRepository) FindRevealedBySession(ctx context.Context, sessionId string) ([]domain.BidCommitment, error) {
+	sql := `
+SELECT id, asset_id, bidder_fp, session_id, commitment, status, committed_at,
+       COALESCE(bid_id, ''), COALESCE(nonce, ''), COALESCE(amount, 0), revealed_at
+FROM bid_commitment
+WHERE session_id = $1 AND status = $2
+ORDER BY committed_at`
+	rows, err := repo.dbPool.Query(ctx, sql, sessionId, domain.RevealedBid)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching revealed bid commitments: %w", err)
+	}
+	defer rows.Close()
+	var commitments []domain.BidCommitment
+	for rows.Next() {
+		commitment, err := scanCommitment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning bid commitment: %w", err)
+		}
+		commitments = append(commitments, *commitment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error scanning bid commitments: %w", err)
+	}
+	return commitments, nil
+}
+
+// MarkRevealed stores the revealed bid of the commitment. It returns false when the commitment was already revealed.
+func (repo *commitmentRepository) MarkRevealed(ctx context.Context, commitment *domain.BidCommitment) (bool, error) {
+	results, err := repo.dbPool.Exec(ctx, `
+UPDATE bid_commitment
+SET status = $1, amount = $2, nonce = $3, bid_id = $4, revealed_at = $5
+WHERE id = $6 AND status = $7`,
+		commitment.Status,
+		commitment.Amount,
+		commitment.Nonce,
+		commitment.BidId,
+		commitment.RevealedAt,
+		commitment.Id,
+		domain.CommittedBid,
+	)
+	if err != nil {
+		return false, fmt.Errorf("error revealing bid commitment: %w", err)
+	}
+	return results.RowsAffected() == 1, nil
+}
+
+func scanCommitment(row pgx.Row) (*domain.BidCommitment, error) {
+	commitment := &domain.BidCommitment{}
+	err := row.Scan(
+		&commitment.Id,
+		&commitment.AssetId,
+		&commitment.UserFp,
+		&commitment.SessionId,
+		&commitment.Commitment,
+		&commitment.Status,
+		&commitment.CommittedAt,
+		&commitment.BidId,
+		&commitment.Nonce,
+		&commitment.Amount,
+		&commitment.RevealedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return commitment, nil
+}
+
+func NewCommitmentRepository(dbPool *pgxpool.Pool, log slog.Logger) CommitmentRepository {
+	return &commitmentRepository{log: log, dbPool: dbPool}
+}
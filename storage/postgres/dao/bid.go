@@ -14,5 +14,6 @@ func GetBidColumnName() []string {
 		"session_id",
 		"last_until",
 		"placed_at",
+		"quantity",
 	}
 }
@@ -1,5 +1,10 @@
 package postgres
 
+import "errors"
+
+// ErrSessionNotFound is returned, wrapped, when the session looked up doesn't exist.
+var ErrSessionNotFound = errors.New("session not found")
+
 type RowScanError struct {
 	Err       error
 	SkipCount int64
@@ -1,6 +1,9 @@
 package postgres
 
 type Repositories struct {
-	BidRepository     BidRepository
-	SessionRepository SessionRepository
+	BidRepository        BidRepository
+	SessionRepository    SessionRepository
+	CommitmentRepository CommitmentRepository
+	SettlementRepository SettlementRepository
+	ProxyBidRepository   ProxyBidRepository
 }
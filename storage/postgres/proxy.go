@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"xrf197ilz35aq2/core/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ProxyBidRepository interface {
+	Save(ctx context.Context, proxy *domain.ProxyBid) (bool, error)
+	FindBySession(ctx context.Context, sessionId string) ([]domain.ProxyBid, error)
+	Delete(ctx context.Context, sessionId string, userFp string) (bool, error)
+}
+
+type proxyBidRepository struct {
+	log    slog.Logger
+	dbPool *pgxpool.Pool
+}
+
+// Save stores the bidder's proxy bid for the session. A bidder has one proxy bid per session, saving again raises its
+// maximum but keeps its id and creation time, so it keeps its rank among equal maxima. It returns false when the new
+// maximum doesn't raise the existing one.
+func (repo *proxyBidRepository) Save(ctx context.Context, proxy *domain.ProxyBid) (bool, error) {
+	sql := `
+INSERT INTO proxy_bid (id, asset_id, bidder_fp, session_id, max_amount, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (session_id, bidder_fp) DO UPDATE
+SET max_amount = EXCLUDED.max_amount, updated_at = EXCLUDED.updated_at
+WHERE proxy_bid.max_amount < EXCLUDED.max_amount
+RETURNING id, created_at`
+	err := repo.dbPool.QueryRow(ctx, sql,
+		proxy.Id,
+		proxy.AssetId,
+		proxy.UserFp,
+		proxy.SessionId,
+		proxy.MaxAmount,
+		proxy.CreatedAt,
+		proxy.UpdatedAt,
+	).Scan(&proxy.Id, &proxy.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("error saving proxy bid: %w", err)
+	}
+	return true, nil
+}
+
+// FindBySession returns the proxy bids of the session.
+func (repo *proxyBidRepository) FindBySession(ctx context.Context, sessionId string) ([]domain.ProxyBid, error) {
+	sql := `
+SELECT id, asset_id, bidder_fp, session_id, max_amount, created_at, updated_at
+FROM proxy_bid
+WHERE session_id = $1
+ORDER BY max_amount DESC, created_at`
+	rows, err := repo.dbPool.Query(ctx, sql, sessionId)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching proxy bids: %w", err)
+	}
+	defer rows.Close()
+	var proxies []domain.ProxyBid
+	for rows.Next() {
+		var proxy domain.ProxyBid
+		err := rows.Scan(
+			&proxy.Id,
+			&proxy.AssetId,
+			&proxy.UserFp,
+			&proxy.SessionId,
+			&proxy.MaxAmount,
+			&proxy.CreatedAt,
+			&proxy.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning proxy bid: %w", err)
+		}
+		proxies = append(proxies, proxy)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error scanning proxy bids: %w", err)
+	}
+	return proxies, nil
+}
+
+// Delete removes the bidder's proxy bid for the session. It returns false when the bidder had none.
+func (repo *proxyBidRepository) Delete(ctx context.Context, sessionId string, userFp string) (bool, error) {
+	results, err := repo.dbPool.Exec(ctx, `
+DELETE FROM proxy_bid
+WHERE session_id = $1 AND bidder_fp = $2`, sessionId, userFp)
+	if err != nil {
+		return false, fmt.Errorf("error deleting proxy bid: %w", err)
+	}
+	return results.RowsAffected() == 1, nil
+}
+
+func NewProxyBidRepository(dbPool *pgxpool.Pool, log slog.Logger) ProxyBidRepository {
+	return &proxyBidRepository{log: log, dbPool: dbPool}
+}
@@ -2,19 +2,42 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 	"xrf197ilz35aq2/core/domain"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
 )
 
+// sessionColumns are the bid_session columns selected by every session query, in the order scanSession reads them.
+const sessionColumns = `
+	id, auto_execute, user_fp, asset_id, status, session_name, reserve_price, auction_type, end_time, start_time,
+	created_at, current_highest_bid, bid_increment_amount, starting_price, price_step, price_step_seconds, reveal_seconds,
+	unit_price, available_quantity, highest_bid_id, highest_bidder_fp, pricing_rule, soft_close_seconds,
+	extension_seconds, max_extension_seconds, extended_seconds`
+
 type SessionRepository interface {
 	Create(ctx context.Context, session *domain.Session) (string, error)
 	FindById(ctx context.Context, sessionId string) (*domain.Session, error)
 	FindActiveSession(ctx context.Context, assetId string) (*domain.Session, error)
 	FindAllByAssetId(ctx context.Context, assetId string) ([]domain.Session, error)
+	FindAll(ctx context.Context, filter SessionFilter, offset int64, limit int64) ([]domain.Session, int64, error)
+	Update(ctx context.Context, session *domain.Session) (bool, error)
+	FindRunningByAuctionType(ctx context.Context, auctionType string, at time.Time) ([]domain.Session, error)
+	UpdateHighestBid(ctx context.Context, sessionId string, currentHighestBid decimal.Decimal, newHighestBid decimal.Decimal, leader *domain.Bid) (bool, error)
+	RestoreHighestBid(ctx context.Context, lead domain.Session, leaderBidId string) (bool, error)
+	ReturnInventory(ctx context.Context, sessionId string, quantity float64) error
+	TakeInventory(ctx context.Context, sessionId string, quantity float64) (float64, bool, error)
+	FindDueToOpen(ctx context.Context, at time.Time) ([]domain.Session, error)
+	FindDueToClose(ctx context.Context, at time.Time) ([]domain.Session, error)
+	FindDueToSettle(ctx context.Context, at time.Time) ([]domain.Session, error)
+	UpdateStatus(ctx context.Context, transition domain.SessionTransition) (bool, error)
+	ExtendEndTime(ctx context.Context, extension domain.SessionExtension) (bool, error)
 }
 
 type sessionRepository struct {
@@ -30,9 +53,11 @@ func (ses *sessionRepository) Create(ctx context.Context, session *domain.Sessio
 
 	results, err := conn.Exec(ctx, // RETURNING id: This tells PostgresSQL to return the value of the id column after insertion
 		`
-INSERT INTO  sessions (id, session_name, user_fp, asset_id, created_at, end_time, start_time, status,
-                       current_highest_bid, auction_type, reserve_price, auto_execute, bid_increment_amount)
-VALUES ($1, $2, $3,  $4, $5, $6, $7, $8, $9, $10, $11,  $12)
+INSERT INTO bid_session (id, session_name, user_fp, asset_id, created_at, end_time, start_time, status,
+                       current_highest_bid, auction_type, reserve_price, auto_execute, bid_increment_amount,
+                       starting_price, price_step, price_step_seconds, reveal_seconds, unit_price, available_quantity,
+                       pricing_rule, soft_close_seconds, extension_seconds, max_extension_seconds)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23)
 RETURNING id
 `,
 		session.Id,
@@ -48,6 +73,16 @@ RETURNING id
 		session.ReservePrice,
 		session.AutoExecute,
 		session.BidIncrementAmount,
+		session.StartingPrice,
+		session.PriceStep,
+		session.PriceStepSeconds,
+		session.RevealSeconds,
+		session.UnitPrice,
+		session.AvailableQuantity,
+		session.PricingRule,
+		session.SoftCloseSeconds,
+		session.ExtensionSeconds,
+		session.MaxExtensionSeconds,
 	)
 	if err != nil {
 		if err = conn.Rollback(ctx); err != nil {
@@ -67,117 +102,135 @@ RETURNING id
 }
 
 func (ses *sessionRepository) FindById(ctx context.Context, sessionId string) (*domain.Session, error) {
-	session := &domain.Session{}
-	err := ses.dbPool.QueryRow(ctx, `
-SELECT id, 
-       auto_execute, 
-       user_fp, 
-       asset_id,
-       status, 
-       session_name, 
-       reserve_price,
-       auction_type,
-       end_time,
-       start_time,
-       created_at,
-       current_highest_bid,
-       bid_increment_amount
-FROM sessions
-WHERE id = $1`, sessionId).Scan(
-		&session.Id,
-		&session.AutoExecute,
-		&session.UserFp,
-		&session.AssetId,
-		&session.Status,
-		&session.Name,
-		&session.ReservePrice,
-		&session.ActionType,
-		&session.EndTime,
-		&session.StartTime,
-		&session.CreatedAt,
-		&session.CurrentHighestBid,
-		&session.BidIncrementAmount,
-	)
+	row := ses.dbPool.QueryRow(ctx, `
+SELECT `+sessionColumns+`
+FROM bid_session
+WHERE id = $1`, sessionId)
+	session, err := scanSession(row)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("failed to find session %s: %w", sessionId, ErrSessionNotFound)
+		}
 		return nil, fmt.Errorf("failed to find session by id: %w", err)
 	}
 
 	return session, nil
 }
 
+// SessionFilter narrows the sessions listed by FindAll, empty fields don't filter. From and To select the sessions
+// running at some point of the time window.
+type SessionFilter struct {
+	AssetId     string
+	OwnerFp     string
+	Status      string
+	AuctionType string
+	From        *time.Time
+	To          *time.Time
+}
+
+// where builds the SQL condition of the filter with its arguments.
+func (filter SessionFilter) where() (string, []any) {
+	conditions := make([]string, 0)
+	args := make([]any, 0)
+	addCondition := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+	if filter.AssetId != "" {
+		addCondition("asset_id = $%d", filter.AssetId)
+	}
+	if filter.OwnerFp != "" {
+		addCondition("user_fp = $%d", filter.OwnerFp)
+	}
+	if filter.Status != "" {
+		addCondition("status = $%d", filter.Status)
+	}
+	if filter.AuctionType != "" {
+		addCondition("auction_type = $%d", filter.AuctionType)
+	}
+	if filter.From != nil {
+		addCondition("end_time > $%d", *filter.From)
+	}
+	if filter.To != nil {
+		addCondition("start_time < $%d", *filter.To)
+	}
+	if len(conditions) == 0 {
+		return "", args
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// FindAll returns a page of the sessions matching the filter, the newest first, and how many sessions match it.
+func (ses *sessionRepository) FindAll(ctx context.Context, filter SessionFilter, offset int64, limit int64) ([]domain.Session, int64, error) {
+	where, args := filter.where()
+
+	var total int64
+	err := ses.dbPool.QueryRow(ctx, `SELECT COUNT(*) FROM bid_session `+where, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count sessions: %w", err)
+	}
+
+	args = append(args, limit, offset)
+	sql := fmt.Sprintf(`
+SELECT `+sessionColumns+`
+FROM bid_session
+%s
+ORDER BY created_at DESC, id
+LIMIT $%d OFFSET $%d`, where, len(args)-1, len(args))
+	rows, err := ses.dbPool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to find sessions: %w", err)
+	}
+	sessions, err := scanSessions(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return sessions, total, nil
+}
+
+// Update persists the editable settings of the scheduled session, only if it still is scheduled.
+// It returns false when the session was opened or cancelled first.
+func (ses *sessionRepository) Update(ctx context.Context, session *domain.Session) (bool, error) {
+	results, err := ses.dbPool.Exec(ctx, `
+UPDATE bid_session
+SET session_name = $1, reserve_price = $2, end_time = $3
+WHERE id = $4
+AND status = $5`, session.Name, session.ReservePrice, session.EndTime, session.Id, domain.ScheduledSession)
+	if err != nil {
+		return false, fmt.Errorf("failed to update session: %w", err)
+	}
+	return results.RowsAffected() == 1, nil
+}
+
 func (ses *sessionRepository) FindAllByAssetId(ctx context.Context, assetId string) ([]domain.Session, error) {
 	sql := `
-SELECT 
-	id, auto_execute, user_fp, asset_id, status, session_name, reserve_price, auction_type, end_time, created_at, 
-	current_highest_bid, bid_increment_amount
-FROM sessions
+SELECT ` + sessionColumns + `
+FROM bid_session
 WHERE asset_id = $1
 `
 	rows, err := ses.dbPool.Query(ctx, sql, assetId)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find sessions by asset id: %w", err)
 	}
-	defer rows.Close()
-	var sessions []domain.Session
-	for rows.Next() {
-		var session domain.Session
-		err = rows.Scan(
-			&session.Id,
-			&session.AutoExecute,
-			&session.UserFp,
-			&session.AssetId,
-			&session.Status,
-			&session.Name,
-			&session.ReservePrice,
-			&session.ActionType,
-			&session.EndTime,
-			&session.CreatedAt,
-			&session.CurrentHighestBid,
-			&session.BidIncrementAmount,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("error scanning of the sessions: %w", err)
-		}
-		sessions = append(sessions, session)
-	}
-	return sessions, nil
+	return scanSessions(rows)
 }
 
 func (ses *sessionRepository) FindActiveSession(ctx context.Context, assetId string) (*domain.Session, error) {
 	now := time.Now()
 	sql := `
-SELECT id, auto_execute, user_fp, asset_id, status, session_name, reserve_price, auction_type, end_time, created_at, 
-	current_highest_bid, bid_increment_amount
-FROM sessions
+SELECT ` + sessionColumns + `
+FROM bid_session
 WHERE asset_id = $1
-AND end_time > $2`
+AND status = $2
+AND end_time > $3`
 
-	rows, err := ses.dbPool.Query(ctx, sql, assetId, now)
+	rows, err := ses.dbPool.Query(ctx, sql, assetId, domain.ActiveSession, now)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find active session: %w", err)
 	}
-	defer rows.Close()
-	var sessions []domain.Session
-	for rows.Next() {
-		var session domain.Session
-		err = rows.Scan(
-			&session.Id,
-			&session.AutoExecute,
-			&session.UserFp,
-			&session.AssetId,
-			&session.Status,
-			&session.Name,
-			&session.ReservePrice,
-			&session.ActionType,
-			&session.EndTime,
-			&session.CreatedAt,
-			&session.CurrentHighestBid,
-			&session.BidIncrementAmount,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("error scanning of the sessions: %w", err)
-		}
-		sessions = append(sessions, session)
+	sessions, err := scanSessions(rows)
+	if err != nil {
+		return nil, err
 	}
 	if len(sessions) == 0 {
 		return nil, fmt.Errorf("there are no active sessions for the asset")
@@ -188,6 +241,217 @@ AND end_time > $2`
 	return &sessions[0], nil
 }
 
+// FindRunningByAuctionType returns the active sessions of the auctionType that have started and not yet ended at the given time.
+func (ses *sessionRepository) FindRunningByAuctionType(ctx context.Context, auctionType string, at time.Time) ([]domain.Session, error) {
+	sql := `
+SELECT ` + sessionColumns + `
+FROM bid_session
+WHERE auction_type = $1
+AND status = $2
+AND start_time <= $3
+AND end_time > $3`
+
+	rows, err := ses.dbPool.Query(ctx, sql, auctionType, domain.ActiveSession, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find running sessions by auction type: %w", err)
+	}
+	return scanSessions(rows)
+}
+
+// UpdateHighestBid sets the session's current highest bid to newHighestBid, placed by the leader bid, only if it still
+// is currentHighestBid and the session still runs. It returns false when another bid changed the session's highest bid
+// first, or the session was closed, cancelled or ended meanwhile.
+func (ses *sessionRepository) UpdateHighestBid(ctx context.Context, sessionId string, currentHighestBid decimal.Decimal, newHighestBid decimal.Decimal, leader *domain.Bid) (bool, error) {
+	results, err := ses.dbPool.Exec(ctx, `
+UPDATE bid_session
+SET current_highest_bid = $1, highest_bid_id = $2, highest_bidder_fp = $3
+WHERE id = $4
+AND current_highest_bid = $5
+AND status = $6
+AND end_time > now()`, newHighestBid, leader.Id, leader.UserFp, sessionId, currentHighestBid, domain.ActiveSession)
+	if err != nil {
+		return false, fmt.Errorf("failed to update session highest bid: %w", err)
+	}
+	return results.RowsAffected() == 1, nil
+}
+
+// RestoreHighestBid gives the session's lead to the bid leading the lead state, e.g., its previous one, only if the
+// leaderBidId bid still leads it. Unlike UpdateHighestBid it doesn't check the session still runs: it takes the lead
+// away from a bid that mustn't keep it.
+func (ses *sessionRepository) RestoreHighestBid(ctx context.Context, lead domain.Session, leaderBidId string) (bool, error) {
+	results, err := ses.dbPool.Exec(ctx, `
+UPDATE bid_session
+SET current_highest_bid = $1, highest_bid_id = $2, highest_bidder_fp = $3
+WHERE id = $4
+AND highest_bid_id = $5`, lead.CurrentHighestBid, lead.HighestBidId, lead.HighestBidderFp, lead.Id, leaderBidId)
+	if err != nil {
+		return false, fmt.Errorf("failed to restore session highest bid: %w", err)
+	}
+	return results.RowsAffected() == 1, nil
+}
+
+// ReturnInventory gives back quantity units taken from the session's available quantity by TakeInventory.
+func (ses *sessionRepository) ReturnInventory(ctx context.Context, sessionId string, quantity float64) error {
+	_, err := ses.dbPool.Exec(ctx, `
+UPDATE bid_session
+SET available_quantity = available_quantity + $1
+WHERE id = $2`, quantity, sessionId)
+	if err != nil {
+		return fmt.Errorf("failed to return session inventory: %w", err)
+	}
+	return nil
+}
+
+// TakeInventory atomically takes quantity units from the session's available quantity and returns the units left.
+// It returns false when fewer units than quantity are available, or the session was closed, cancelled or ended meanwhile.
+func (ses *sessionRepository) TakeInventory(ctx context.Context, sessionId string, quantity float64) (float64, bool, error) {
+	var remaining float64
+	err := ses.dbPool.QueryRow(ctx, `
+UPDATE bid_session
+SET available_quantity = available_quantity - $1
+WHERE id = $2
+AND available_quantity >= $1
+AND status = $3
+AND end_time > now()
+RETURNING available_quantity`, quantity, sessionId, domain.ActiveSession).Scan(&remaining)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, false, nil
+		}
+		return 0, false, fmt.Errorf("failed to take session inventory: %w", err)
+	}
+	return remaining, true, nil
+}
+
+// FindDueToOpen returns the scheduled sessions whose start time is reached at the given time.
+func (ses *sessionRepository) FindDueToOpen(ctx context.Context, at time.Time) ([]domain.Session, error) {
+	sql := `
+SELECT ` + sessionColumns + `
+FROM bid_session
+WHERE status = $1
+AND start_time <= $2`
+
+	rows, err := ses.dbPool.Query(ctx, sql, domain.ScheduledSession, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find sessions due to open: %w", err)
+	}
+	return scanSessions(rows)
+}
+
+// FindDueToClose returns the active sessions whose end time is reached at the given time.
+func (ses *sessionRepository) FindDueToClose(ctx context.Context, at time.Time) ([]domain.Session, error) {
+	sql := `
+SELECT ` + sessionColumns + `
+FROM bid_session
+WHERE status = $1
+AND end_time <= $2`
+
+	rows, err := ses.dbPool.Query(ctx, sql, domain.ActiveSession, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find sessions due to close: %w", err)
+	}
+	return scanSessions(rows)
+}
+
+// FindDueToSettle returns the ended sessions that are not settled yet at the given time. Sealed auction sessions are only
+// due once their reveal phase is over as well.
+func (ses *sessionRepository) FindDueToSettle(ctx context.Context, at time.Time) ([]domain.Session, error) {
+	sql := `
+SELECT ` + sessionColumns + `
+FROM bid_session
+WHERE status IN ($1, $2)
+AND end_time + reveal_seconds * INTERVAL '1 second' <= $3
+AND NOT EXISTS (SELECT 1 FROM session_settlement WHERE session_settlement.session_id = bid_session.id)`
+
+	rows, err := ses.dbPool.Query(ctx, sql, domain.ClosedSession, domain.CompletedSession, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find sessions due to settle: %w", err)
+	}
+	return scanSessions(rows)
+}
+
+// UpdateStatus persists the session's transition, only if the session still is in the status it transitioned from.
+// It returns false when the session was moved to another status first, e.g., by another instance.
+func (ses *sessionRepository) UpdateStatus(ctx context.Context, transition domain.SessionTransition) (bool, error) {
+	results, err := ses.dbPool.Exec(ctx, `
+UPDATE bid_session
+SET status = $1, end_time = $2
+WHERE id = $3
+AND status = $4`, transition.To, transition.EndTime, transition.SessionId, transition.From)
+	if err != nil {
+		return false, fmt.Errorf("failed to update session status: %w", err)
+	}
+	return results.RowsAffected() == 1, nil
+}
+
+// ExtendEndTime persists the soft close extension of the active session, only if its end time wasn't moved meanwhile.
+// It returns false when the session was extended or closed first.
+func (ses *sessionRepository) ExtendEndTime(ctx context.Context, extension domain.SessionExtension) (bool, error) {
+	results, err := ses.dbPool.Exec(ctx, `
+UPDATE bid_session
+SET end_time = $1, extended_seconds = $2
+WHERE id = $3
+AND status = $4
+AND end_time = $5`, extension.EndTime, extension.ExtendedSeconds, extension.SessionId, domain.ActiveSession,
+		extension.PreviousEndTime)
+	if err != nil {
+		return false, fmt.Errorf("failed to extend session end time: %w", err)
+	}
+	return results.RowsAffected() == 1, nil
+}
+
+func scanSession(row pgx.Row) (*domain.Session, error) {
+	session := &domain.Session{}
+	err := row.Scan(
+		&session.Id,
+		&session.AutoExecute,
+		&session.UserFp,
+		&session.AssetId,
+		&session.Status,
+		&session.Name,
+		&session.ReservePrice,
+		&session.ActionType,
+		&session.EndTime,
+		&session.StartTime,
+		&session.CreatedAt,
+		&session.CurrentHighestBid,
+		&session.BidIncrementAmount,
+		&session.StartingPrice,
+		&session.PriceStep,
+		&session.PriceStepSeconds,
+		&session.RevealSeconds,
+		&session.UnitPrice,
+		&session.AvailableQuantity,
+		&session.HighestBidId,
+		&session.HighestBidderFp,
+		&session.PricingRule,
+		&session.SoftCloseSeconds,
+		&session.ExtensionSeconds,
+		&session.MaxExtensionSeconds,
+		&session.ExtendedSeconds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+func scanSessions(rows pgx.Rows) ([]domain.Session, error) {
+	defer rows.Close()
+	var sessions []domain.Session
+	for rows.Next() {
+		session, err := scanSession(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning of the sessions: %w", err)
+		}
+		sessions = append(sessions, *session)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error scanning of the sessions: %w", err)
+	}
+	return sessions, nil
+}
+
 func NewSessionRepository(dbPool *pgxpool.Pool, log slog.Logger) SessionRepository {
 	return &sessionRepository{log: log, dbPool: dbPool}
 }
@@ -0,0 +1,149 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+	"xrf197ilz35aq2/core/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SettlementRepository interface {
+	Create(ctx context.Context, settlement *domain.Settlement) (bool, error)
+	FindBySessionId(ctx context.Context, sessionId string) (*domain.Settlement, error)
+	UpdateStatus(ctx context.Context, settlementId string, from string, to string, executedAt *time.Time) (bool, error)
+}
+
+type settlementRepository struct {
+	log    slog.Logger
+	dbPool *pgxpool.Pool
+}
+
+// Create stores the settlement with its awards. A session is settled once, it returns false when the session already
+// has a settlement, e.g., created by another instance.
+func (repo *settlementRepository) Create(ctx context.Context, settlement *domain.Settlement) (bool, error) {
+	tx, err := repo.dbPool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin create settlement tx: %w", err)
+	}
+	defer func(tx pgx.Tx, ctx context.Context) {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			repo.log.Error("error rolling back transaction for create settlement", "err", err)
+		}
+	}(tx, ctx) //Rollback on error
+
+	results, err := tx.Exec(ctx, `
+INSERT INTO session_settlement (id, session_id, asset_id, seller_fp, auction_type, status, reserve_met, reserve_price,
+                                settled_at, executed_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+ON CONFLICT (session_id) DO NOTHING`,
+		settlement.Id,
+		settlement.SessionId,
+		settlement.AssetId,
+		settlement.SellerFp,
+		settlement.AuctionType,
+		settlement.Status,
+		settlement.ReserveMet,
+		settlement.ReservePrice,
+		settlement.SettledAt,
+		settlement.ExecutedAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("error creating settlement: %w", err)
+	}
+	if results.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	batch := &pgx.Batch{}
+	for _, award := range settlement.Awards {
+		batch.Queue(`
+INSERT INTO settlement_award (settlement_id, bid_id, winner_fp, bid_amount, clearing_price, quantity)
+VALUES ($1, $2, $3, $4, $5, $6)`,
+			settlement.Id,
+			award.BidId,
+			award.UserFp,
+			award.BidAmount,
+			award.Price,
+			award.Quantity,
+		)
+	}
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return false, fmt.Errorf("error creating settlement awards: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("error committing settlement: %w", err)
+	}
+	return true, nil
+}
+
+// FindBySessionId returns the settlement of the session with its awards, or nil when the session isn't settled yet.
+func (repo *settlementRepository) FindBySessionId(ctx context.Context, sessionId string) (*domain.Settlement, error) {
+	settlement := &domain.Settlement{}
+	err := repo.dbPool.QueryRow(ctx, `
+SELECT id, session_id, asset_id, seller_fp, auction_type, status, reserve_met, reserve_price, settled_at, executed_at
+FROM session_settlement
+WHERE session_id = $1`, sessionId).Scan(
+		&settlement.Id,
+		&settlement.SessionId,
+		&settlement.AssetId,
+		&settlement.SellerFp,
+		&settlement.AuctionType,
+		&settlement.Status,
+		&settlement.ReserveMet,
+		&settlement.ReservePrice,
+		&settlement.SettledAt,
+		&settlement.ExecutedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error fetching settlement: %w", err)
+	}
+
+	rows, err := repo.dbPool.Query(ctx, `
+SELECT bid_id, winner_fp, bid_amount, clearing_price, quantity
+FROM settlement_award
+WHERE settlement_id = $1
+ORDER BY bid_amount DESC`, settlement.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching settlement awards: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var award domain.Award
+		err := rows.Scan(&award.BidId, &award.UserFp, &award.BidAmount, &award.Price, &award.Quantity)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning settlement award: %w", err)
+		}
+		settlement.Awards = append(settlement.Awards, award)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error scanning settlement awards: %w", err)
+	}
+	return settlement, nil
+}
+
+// UpdateStatus moves the settlement to the status to, only if it still is in the status from.
+func (repo *settlementRepository) UpdateStatus(ctx context.Context, settlementId string, from string, to string, executedAt *time.Time) (bool, error) {
+	results, err := repo.dbPool.Exec(ctx, `
+UPDATE session_settlement
+SET status = $1, executed_at = $2
+WHERE id = $3
+AND status = $4`, to, executedAt, settlementId, from)
+	if err != nil {
+		return false, fmt.Errorf("error updating settlement status: %w", err)
+	}
+	return results.RowsAffected() == 1, nil
+}
+
+func NewSettlementRepository(dbPool *pgxpool.Pool, log slog.Logger) SettlementRepository {
+	return &settlementRepository{log: log, dbPool: dbPool}
+}
@@ -8,13 +8,53 @@ import (
 	"log/slog"
 	"time"
 	"xrf197ilz35aq2/core/domain"
-	"xrf197ilz35aq2/internal/exchange"
 
 	"github.com/redis/go-redis/v9"
 )
 
+// BidQueue is the queue placed bids wait in until the BidWorker persists them.
+const BidQueue = "bid_queue"
+
+// bidRecordRetention is how long a bid stays readable by id after it can no longer be placed (its LastUntil).
+const bidRecordRetention = 24 * time.Hour
+
+// dequeueScript decrements the queued bids count of a session, KEYS[1], unless it expired meanwhile.
+var dequeueScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	return redis.call('DECR', KEYS[1])
+end
+return 0
+`)
+
 type BidCache interface {
-	SaveBid(ctx context.Context, request exchange.BidRequest, sessionId string) (*domain.Bid, error)
+	SaveBid(ctx context.Context, bid *domain.Bid) error
+	// QueuedBids is how many of the session's bids wait in the BidQueue to be persisted.
+	QueuedBids(ctx context.Context, sessionId string) (int64, error)
+	// DequeueBids records that the bids were taken from the BidQueue.
+	DequeueBids(ctx context.Context, bids []domain.Bid) error
+	// RequeueBids puts the cached bids taken from the BidQueue back, they still count as queued.
+	RequeueBids(ctx context.Context, cachedBids []string) error
+	FindBid(ctx context.Context, bidId string) (*domain.Bid, error)
+	CancelBid(ctx context.Context, bid *domain.Bid) error
+	CancelledBids(ctx context.Context, bidIds []string) (map[string]bool, error)
+	HighestBid(ctx context.Context, sessionId string) (*domain.Bid, error)
+	SessionBids(ctx context.Context, sessionId string) ([]domain.Bid, error)
+	SubscribeSessionBids(ctx context.Context, sessionId string) (*BidSubscription, error)
+}
+
+// BidSubscription receives the bids placed, or cancelled, in a session as they happen.
+type BidSubscription struct {
+	pubSub *redis.PubSub
+	bids   chan domain.Bid
+}
+
+// Bids is closed once the subscription is closed.
+func (sub *BidSubscription) Bids() <-chan domain.Bid {
+	return sub.bids
+}
+
+func (sub *BidSubscription) Close() error {
+	return sub.pubSub.Close()
 }
 
 type bidCache struct {
@@ -22,32 +62,224 @@ type bidCache struct {
 	client *redis.Client
 }
 
-func (cache *bidCache) SaveBid(ctx context.Context, request exchange.BidRequest, sessionId string) (*domain.Bid, error) {
-	if request.Amount <= 0 {
-		return nil, errors.New("invalid amount")
+// SaveBid queues the bid to be persisted. The bid is also kept by id, and by amount among the session's accepted bids,
+// so it can be retracted before the BidWorker persisted it.
+func (cache *bidCache) SaveBid(ctx context.Context, bid *domain.Bid) error {
+	// 1. Push Bid to Redis Queue
+	bidJSON, err := json.Marshal(bid)
+	if err != nil {
+		return fmt.Errorf("marshaling new bid failed with err=%w", err)
 	}
-	assetId := request.AssetId
-	newBid, err := domain.NewBid(request.UserFp, request.Amount, assetId, request.LastUntil, sessionId)
+	retention := time.Until(bid.LastUntil) + bidRecordRetention
+	_, err = cache.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		// RPush inserts all the specified values at the tail of the list stored at the key. If the key does not exist,
+		// it is created as an empty list before performing the push operation. When the key holds a value that is not a list, an error is returned
+		pipe.RPush(ctx, BidQueue, bidJSON)
+		pipe.Incr(ctx, queuedBidsKey(bid.SessionId))
+		pipe.Expire(ctx, queuedBidsKey(bid.SessionId), retention)
+		pipe.Set(ctx, bidRecordKey(bid.Id), bidJSON, retention)
+		if bid.Accepted {
+			// the score only ranks the bids, the exact amount is kept by the bid record
+			pipe.ZAdd(ctx, sessionBidsKey(bid.SessionId), redis.Z{Score: bid.Amount.InexactFloat64(), Member: bid.Id})
+			// the session's accepted bids are kept as long as its latest bid
+			pipe.ExpireNX(ctx, sessionBidsKey(bid.SessionId), retention)
+			pipe.ExpireGT(ctx, sessionBidsKey(bid.SessionId), retention)
+		}
+		pipe.Publish(ctx, sessionBidsChannel(bid.SessionId), bidJSON)
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("creating new bid failed with err=%w", err)
+		return fmt.Errorf("saving new bid failed with err=%w", err)
 	}
+	return nil
+}
 
-	// 1. Push Bid to Redis Queue
-	bidJSON, err := json.Marshal(newBid)
+// QueuedBids is how many of the session's bids wait in the BidQueue to be persisted.
+func (cache *bidCache) QueuedBids(ctx context.Context, sessionId string) (int64, error) {
+	queued, err := cache.client.Get(ctx, queuedBidsKey(sessionId)).Int64()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("fetching queued session bids failed with err=%w", err)
+	}
+	return queued, nil
+}
+
+// DequeueBids records that the bids were taken from the BidQueue and persisted.
+func (cache *bidCache) DequeueBids(ctx context.Context, bids []domain.Bid) error {
+	for _, bid := range bids {
+		if err := dequeueScript.Run(ctx, cache.client, []string{queuedBidsKey(bid.SessionId)}).Err(); err != nil {
+			return fmt.Errorf("dequeuing bids failed with err=%w", err)
+		}
+	}
+	return nil
+}
+
+// RequeueBids pushes the cached bids back at the tail of the BidQueue, e.g., when persisting them failed. Their sessions'
+// queued bids are left as is, the bids were never dequeued.
+func (cache *bidCache) RequeueBids(ctx context.Context, cachedBids []string) error {
+	if len(cachedBids) == 0 {
+		return nil
+	}
+	values := make([]interface{}, 0, len(cachedBids))
+	for _, cachedBid := range cachedBids {
+		values = append(values, cachedBid)
+	}
+	if err := cache.client.RPush(ctx, BidQueue, values...).Err(); err != nil {
+		return fmt.Errorf("requeuing bids failed with err=%w", err)
+	}
+	return nil
+}
+
+// FindBid returns the bid by id, or nil when it's not (or no longer) cached.
+func (cache *bidCache) FindBid(ctx context.Context, bidId string) (*domain.Bid, error) {
+	bidJSON, err := cache.client.Get(ctx, bidRecordKey(bidId)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("fetching cached bid failed with err=%w", err)
+	}
+	var bid domain.Bid
+	if err := json.Unmarshal(bidJSON, &bid); err != nil {
+		return nil, fmt.Errorf("unmarshalling cached bid failed with err=%w", err)
+	}
+	return &bid, nil
+}
+
+// CancelBid records the cancelled bid, it's removed from the session's accepted bids and the BidWorker persists it as
+// cancelled if it's still queued.
+func (cache *bidCache) CancelBid(ctx context.Context, bid *domain.Bid) error {
+	bidJSON, err := json.Marshal(bid)
 	if err != nil {
-		return nil, fmt.Errorf("marshaling new bid failed with err=%w", err)
+		return fmt.Errorf("marshaling cancelled bid failed with err=%w", err)
 	}
-	// RPush inserts all the specified values at the tail of the list stored at the key. If the key does not exist,
-	// it is created as an empty list before performing the push operation. When the key holds a value that is not a list, an error is returned
-	err = cache.client.RPush(ctx, bidKey(assetId, sessionId, request.LastUntil), bidJSON).Err()
+	retention := time.Until(bid.LastUntil) + bidRecordRetention
+	_, err = cache.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, bidRecordKey(bid.Id), bidJSON, retention)
+		pipe.Set(ctx, cancelledBidKey(bid.Id), bid.Status, retention)
+		pipe.ZRem(ctx, sessionBidsKey(bid.SessionId), bid.Id)
+		pipe.Publish(ctx, sessionBidsChannel(bid.SessionId), bidJSON)
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("saving new bid failed with err=%w", err)
+		return fmt.Errorf("cancelling bid failed with err=%w", err)
 	}
-	return newBid, nil
+	return nil
+}
+
+// CancelledBids reports which of the bids were cancelled.
+func (cache *bidCache) CancelledBids(ctx context.Context, bidIds []string) (map[string]bool, error) {
+	cancelled := make(map[string]bool, len(bidIds))
+	if len(bidIds) == 0 {
+		return cancelled, nil
+	}
+	keys := make([]string, 0, len(bidIds))
+	for _, bidId := range bidIds {
+		keys = append(keys, cancelledBidKey(bidId))
+	}
+	values, err := cache.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("fetching cancelled bids failed with err=%w", err)
+	}
+	for i, value := range values {
+		cancelled[bidIds[i]] = value != nil
+	}
+	return cancelled, nil
+}
+
+// HighestBid returns the session's accepted bid with the highest amount, or nil when the session has none.
+func (cache *bidCache) HighestBid(ctx context.Context, sessionId string) (*domain.Bid, error) {
+	bidIds, err := cache.client.ZRevRange(ctx, sessionBidsKey(sessionId), 0, 0).Result()
+	if err != nil {
+		return nil, fmt.Errorf("fetching highest session bid failed with err=%w", err)
+	}
+	if len(bidIds) == 0 {
+		return nil, nil
+	}
+	return cache.FindBid(ctx, bidIds[0])
+}
+
+// SessionBids returns the session's accepted bids still cached, whether or not they are persisted yet.
+func (cache *bidCache) SessionBids(ctx context.Context, sessionId string) ([]domain.Bid, error) {
+	bidIds, err := cache.client.ZRange(ctx, sessionBidsKey(sessionId), 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("fetching session bids failed with err=%w", err)
+	}
+	if len(bidIds) == 0 {
+		return nil, nil
+	}
+	keys := make([]string, 0, len(bidIds))
+	for _, bidId := range bidIds {
+		keys = append(keys, bidRecordKey(bidId))
+	}
+	values, err := cache.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("fetching session bids failed with err=%w", err)
+	}
+	bids := make([]domain.Bid, 0, len(values))
+	for _, value := range values {
+		bidJSON, ok := value.(string)
+		if !ok {
+			continue // expired meanwhile
+		}
+		var bid domain.Bid
+		if err := json.Unmarshal([]byte(bidJSON), &bid); err != nil {
+			cache.log.Error("failed to unmarshal cached session bid", "sessionId", sessionId, "err", err)
+			continue
+		}
+		bids = append(bids, bid)
+	}
+	return bids, nil
+}
+
+// SubscribeSessionBids subscribes to the bids placed, or cancelled, in the session from now on.
+func (cache *bidCache) SubscribeSessionBids(ctx context.Context, sessionId string) (*BidSubscription, error) {
+	pubSub := cache.client.Subscribe(ctx, sessionBidsChannel(sessionId))
+	// wait for the subscription to be confirmed, bids published after it returns are received
+	if _, err := pubSub.Receive(ctx); err != nil {
+		_ = pubSub.Close()
+		return nil, fmt.Errorf("subscribing to session bids failed with err=%w", err)
+	}
+
+	sub := &BidSubscription{pubSub: pubSub, bids: make(chan domain.Bid)}
+	go func() {
+		defer close(sub.bids)
+		for message := range pubSub.Channel() {
+			var bid domain.Bid
+			if err := json.Unmarshal([]byte(message.Payload), &bid); err != nil {
+				cache.log.Error("failed to unmarshal published session bid", "sessionId", sessionId, "err", err)
+				continue
+			}
+			select {
+			case sub.bids <- bid:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return sub, nil
+}
+
+func bidRecordKey(bidId string) string {
+	return fmt.Sprintf("bid_%s", bidId)
+}
+
+func queuedBidsKey(sessionId string) string {
+	return fmt.Sprintf("queued_session_bids_%s", sessionId)
+}
+
+func cancelledBidKey(bidId string) string {
+	return fmt.Sprintf("cancelled_bid_%s", bidId)
+}
+
+func sessionBidsKey(sessionId string) string {
+	return fmt.Sprintf("session_bids_%s", sessionId)
 }
 
-func bidKey(assetId string, sessionId string, sessionEndTime time.Time) string {
-	return fmt.Sprintf("bid_%s_%d_%s", assetId, sessionEndTime.UnixMilli(), sessionId)
+func sessionBidsChannel(sessionId string) string {
+	return fmt.Sprintf("session_bids_channel_%s", sessionId)
 }
 
 func NewBidCache(log slog.Logger, client *redis.Client) BidCache {
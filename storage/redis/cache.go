@@ -1,5 +1,6 @@
 package redis
 
 type CacheClients struct {
-	BidClient BidCache
+	BidClient         BidCache
+	IdempotencyClient IdempotencyCache
 }
@@ -0,0 +1,119 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// idempotencyKeyTTL is how long the response of a request is replayed to the retries with its idempotency key.
+	idempotencyKeyTTL = 24 * time.Hour
+	// idempotencyReservationTTL bounds how long a key stays reserved by a request that never completed, e.g., the
+	// process died while serving it.
+	idempotencyReservationTTL = 30 * time.Second
+)
+
+// IdempotencyRecord is what's kept of a request made with an idempotency key: the fingerprint of its payload and, once
+// it completed, its response.
+type IdempotencyRecord struct {
+	Fingerprint string `json:"fingerprint"`
+	Response    []byte `json:"response,omitempty"`
+}
+
+// Completed reports whether the request of the record was served, otherwise it's still in progress.
+func (record *IdempotencyRecord) Completed() bool {
+	return len(record.Response) > 0
+}
+
+type IdempotencyCache interface {
+	// Reserve claims the key for a request with the fingerprint. It returns nil when the key was free, the record of
+	// the request that claimed it first otherwise.
+	Reserve(ctx context.Context, scope, key, fingerprint string) (*IdempotencyRecord, error)
+	// Hold keeps the key reserved as long as a completed request's response would be kept, once the request did
+	// something its retries mustn't do again.
+	Hold(ctx context.Context, scope, key string) error
+	// Complete keeps the response of the request that reserved the key, to be replayed to its retries.
+	Complete(ctx context.Context, scope, key string, record IdempotencyRecord) error
+	// Release frees the key of a request that failed, so it can be retried.
+	Release(ctx context.Context, scope, key string) error
+}
+
+type idempotencyCache struct {
+	log    slog.Logger
+	client *redis.Client
+}
+
+func (cache *idempotencyCache) Reserve(ctx context.Context, scope, key, fingerprint string) (*IdempotencyRecord, error) {
+	recordJSON, err := json.Marshal(IdempotencyRecord{Fingerprint: fingerprint})
+	if err != nil {
+		return nil, fmt.Errorf("marshaling idempotency record failed with err=%w", err)
+	}
+	reserved, err := cache.client.SetNX(ctx, idempotencyKey(scope, key), recordJSON, idempotencyReservationTTL).Result()
+	if err != nil {
+		return nil, fmt.Errorf("reserving idempotency key failed with err=%w", err)
+	}
+	if reserved {
+		return nil, nil
+	}
+
+	existingJSON, err := cache.client.Get(ctx, idempotencyKey(scope, key)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		// expired in between, the caller retries
+		return nil, fmt.Errorf("idempotency key expired while reserving it")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("fetching idempotency record failed with err=%w", err)
+	}
+	var existing IdempotencyRecord
+	if err = json.Unmarshal(existingJSON, &existing); err != nil {
+		return nil, fmt.Errorf("unmarshaling idempotency record failed with err=%w", err)
+	}
+	return &existing, nil
+}
+
+func (cache *idempotencyCache) Hold(ctx context.Context, scope, key string) error {
+	held, err := cache.client.Expire(ctx, idempotencyKey(scope, key), idempotencyKeyTTL).Result()
+	if err != nil {
+		return fmt.Errorf("holding idempotency key failed with err=%w", err)
+	}
+	if !held {
+		return fmt.Errorf("idempotency key expired before it was held")
+	}
+	return nil
+}
+
+func (cache *idempotencyCache) Complete(ctx context.Context, scope, key string, record IdempotencyRecord) error {
+	recordJSON, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("marshaling idempotency record failed with err=%w", err)
+	}
+	if err = cache.client.Set(ctx, idempotencyKey(scope, key), recordJSON, idempotencyKeyTTL).Err(); err != nil {
+		return fmt.Errorf("completing idempotency key failed with err=%w", err)
+	}
+	return nil
+}
+
+func (cache *idempotencyCache) Release(ctx context.Context, scope, key string) error {
+	if err := cache.client.Del(ctx, idempotencyKey(scope, key)).Err(); err != nil {
+		return fmt.Errorf("releasing idempotency key failed with err=%w", err)
+	}
+	return nil
+}
+
+// idempotencyKey scopes the client's key, e.g., by user, so clients can't replay each other's responses.
+func idempotencyKey(scope, key string) string {
+	return fmt.Sprintf("idempotency_%s_%s", scope, key)
+}
+
+func NewIdempotencyCache(log slog.Logger, client *redis.Client) IdempotencyCache {
+	return &idempotencyCache{
+		log:    log,
+		client: client,
+	}
+}
@@ -6,7 +6,7 @@ CREATE TABLE IF NOT EXISTS bid_records (
     bidder_fp VARCHAR(255) NOT NULL,
     seller_fp VARCHAR(255) NOT NULL,
     session_id VARCHAR(255) NOT NULL,
-    amount DOUBLE PRECISION NOT NULL,
+    amount NUMERIC(38, 9) NOT NULL,
     quantity DOUBLE PRECISION NOT NULL,
     expiration_time TIMESTAMPTZ NOT NULL
 );
@@ -23,3 +23,5 @@ BEGIN
     END IF;
 END;
 	$$;
+----
+ALTER TABLE bid_records ALTER COLUMN amount TYPE NUMERIC(38, 9) USING amount::NUMERIC(38, 9);